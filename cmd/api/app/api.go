@@ -18,8 +18,11 @@ package app
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"os"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/karmada-io/karmada/pkg/sharedcli/klogflag"
@@ -29,11 +32,14 @@ import (
 
 	"github.com/karmada-io/dashboard/cmd/api/app/options"
 	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/aggregated"               // Importing route packages forces route registration
 	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/assistant"                // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/audit"                    // Importing route packages forces route registration
 	"github.com/karmada-io/dashboard/cmd/api/app/routes/auth"                       // Importing route packages forces route registration
 	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/cluster"                  // Importing route packages forces route registration
 	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/clusteroverridepolicy"    // Importing route packages forces route registration
 	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/clusterpropagationpolicy" // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/clusterresourcebinding"   // Importing route packages forces route registration
 	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/config"                   // Importing route packages forces route registration
 	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/configmap"                // Importing route packages forces route registration
 	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/cronjob"                  // Importing route packages forces route registration
@@ -41,17 +47,21 @@ import (
 	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/deployment"               // Importing route packages forces route registration
 	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/ingress"                  // Importing route packages forces route registration
 	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/job"                      // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/manifest"                 // Importing route packages forces route registration
 	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/member"                   // Importing route packages forces route registration
 	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/namespace"                // Importing route packages forces route registration
 	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/overridepolicy"           // Importing route packages forces route registration
 	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/overview"                 // Importing route packages forces route registration
 	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/propagationpolicy"        // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/resourcebinding"          // Importing route packages forces route registration
 	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/secret"                   // Importing route packages forces route registration
 	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/service"                  // Importing route packages forces route registration
 	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/statefulset"              // Importing route packages forces route registration
 	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/terminal"                 // Importing route packages forces route registration
 	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/topology"                 // Importing route packages forces route registration
 	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/unstructured"             // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/work"                     // Importing route packages forces route registration
+	"github.com/karmada-io/dashboard/pkg/audit"
 	"github.com/karmada-io/dashboard/pkg/client"
 	"github.com/karmada-io/dashboard/pkg/config"
 	"github.com/karmada-io/dashboard/pkg/environment"
@@ -118,7 +128,7 @@ func run(ctx context.Context, opts *options.Options) error {
 	)
 	ensureAPIServerConnectionOrDie()
 
-	// Initialize shared informer for topology (ResourceBinding / Work indexers)
+	// Initialize shared informer for topology (ResourceBinding / ClusterResourceBinding / Work indexers)
 	stopper := make(chan struct{})
 	defer close(stopper)
 	informer.Init(client.InClusterKarmadaClient(), stopper)
@@ -141,19 +151,44 @@ func run(ctx context.Context, opts *options.Options) error {
 		}
 	}
 
-	// Initialize OIDC provider if configured
+	// Initialize OIDC, with the provider of the flags if configured
+	var oidcCfg *oidcpkg.Config
 	if opts.OIDCIssuerURL != "" {
-		oidcCfg := &oidcpkg.Config{
+		oidcCfg = &oidcpkg.Config{
+			ID:           "default",
+			DisplayName:  opts.OIDCDisplayName,
 			IssuerURL:    opts.OIDCIssuerURL,
 			ClientID:     opts.OIDCClientID,
 			ClientSecret: opts.OIDCClientSecret,
 			RedirectURL:  opts.OIDCRedirectURL,
 			Scopes:       opts.OIDCScopes,
+
+			PostLogoutRedirectURL: opts.OIDCPostLogoutRedirectURL,
 		}
-		if err := auth.InitOIDCProvider(ctx, oidcCfg); err != nil {
-			klog.Fatalf("Failed to initialize OIDC provider: %v", err)
+		if opts.OIDCImpersonate {
+			oidcCfg.Impersonation = &oidcpkg.ImpersonationConfig{
+				UsernameClaim:  opts.OIDCUsernameClaim,
+				UsernamePrefix: opts.OIDCUsernamePrefix,
+				GroupsClaim:    opts.OIDCGroupsClaim,
+				GroupsPrefix:   opts.OIDCGroupsPrefix,
+			}
 		}
 	}
+	sessionCfg := &oidcpkg.SessionConfig{
+		Secret:       opts.SessionSecret,
+		MaxAge:       opts.SessionMaxAge,
+		SecureCookie: opts.SessionCookieSecure,
+	}
+	if err := auth.InitOIDC(ctx, oidcCfg, sessionCfg); err != nil {
+		klog.Fatalf("Failed to initialize OIDC provider: %v", err)
+	}
+
+	// Initialize the audit log of mutating actions, recent actions are kept in memory even without sinks
+	auditLogger, err := newAuditLogger(opts)
+	if err != nil {
+		klog.Fatalf("Failed to initialize audit log: %v", err)
+	}
+	router.SetAuditLogger(auditLogger)
 
 	// Initialize MCP client (optional, factory pattern)
 	var mcpClient *mcpclient.MCPClient
@@ -185,7 +220,7 @@ func run(ctx context.Context, opts *options.Options) error {
 		}
 	}
 
-	serve(opts, mcpClient)
+	server := serve(opts, mcpClient)
 	config.InitDashboardConfig(client.InClusterClient(), ctx.Done())
 
 	// Cleanup on shutdown
@@ -194,10 +229,38 @@ func run(ctx context.Context, opts *options.Options) error {
 	}
 
 	<-ctx.Done()
+	// Requests still being served record to the audit log, let them finish before its sinks are closed
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		klog.ErrorS(err, "Failed to shut down server")
+	}
+	cancel()
+	// Deferred calls do not run on os.Exit, flush the audit sinks first
+	if err := auditLogger.Close(); err != nil {
+		klog.ErrorS(err, "Failed to close audit log")
+	}
 	os.Exit(0)
 	return nil
 }
 
+func newAuditLogger(opts *options.Options) (*audit.Logger, error) {
+	var sinks []audit.Sink
+	if opts.AuditLogPath != "" {
+		sink, err := audit.NewFileSink(opts.AuditLogPath)
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+	}
+	if opts.AuditLogStdout {
+		sinks = append(sinks, audit.NewStreamSink(os.Stdout))
+	}
+	if opts.AuditWebhookURL != "" {
+		sinks = append(sinks, audit.NewWebhookSink(opts.AuditWebhookURL, opts.AuditWebhookTimeout))
+	}
+	return audit.NewLogger(opts.AuditRecentEntries, sinks...), nil
+}
+
 func ensureAPIServerConnectionOrDie() {
 	versionInfo, err := client.InClusterClient().Discovery().ServerVersion()
 	if err != nil {
@@ -216,7 +279,10 @@ func ensureAPIServerConnectionOrDie() {
 	klog.InfoS("Successful initial request to the Karmada apiserver", "version", karmadaVersionInfo.String())
 }
 
-func serve(opts *options.Options, mcpClient *mcpclient.MCPClient) {
+// shutdownTimeout bounds how long in-flight requests are waited for on shutdown.
+const shutdownTimeout = 10 * time.Second
+
+func serve(opts *options.Options, mcpClient *mcpclient.MCPClient) *http.Server {
 	// Add middleware to inject MCP client into context
 	if mcpClient != nil {
 		router.Router().Use(func(c *gin.Context) {
@@ -228,7 +294,11 @@ func serve(opts *options.Options, mcpClient *mcpclient.MCPClient) {
 
 	insecureAddress := fmt.Sprintf("%s:%d", opts.InsecureBindAddress, opts.InsecurePort)
 	klog.V(1).InfoS("Listening and serving on", "address", insecureAddress)
+	server := &http.Server{Addr: insecureAddress, Handler: router.Router().Handler()}
 	go func() {
-		klog.Fatal(router.Router().Run(insecureAddress))
+		if err := server.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+			klog.Fatal(err)
+		}
 	}()
+	return server
 }
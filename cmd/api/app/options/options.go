@@ -57,6 +57,24 @@ type Options struct {
 	OIDCClientSecret string
 	OIDCRedirectURL  string
 	OIDCScopes       []string
+
+	OIDCDisplayName           string
+	OIDCPostLogoutRedirectURL string
+	OIDCImpersonate           bool
+	OIDCUsernameClaim         string
+	OIDCUsernamePrefix        string
+	OIDCGroupsClaim           string
+	OIDCGroupsPrefix          string
+	SessionSecret             string
+	SessionMaxAge             time.Duration
+	SessionCookieSecure       bool
+
+	// Audit related options
+	AuditLogPath        string
+	AuditLogStdout      bool
+	AuditWebhookURL     string
+	AuditWebhookTimeout time.Duration
+	AuditRecentEntries  int
 }
 
 // NewOptions returns initialized Options.
@@ -101,4 +119,21 @@ func (o *Options) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&o.OIDCClientSecret, "oidc-client-secret", "", "OIDC client secret")
 	fs.StringVar(&o.OIDCRedirectURL, "oidc-redirect-url", "", "OIDC redirect URL (e.g., https://dashboard.example.com/login/callback)")
 	fs.StringSliceVar(&o.OIDCScopes, "oidc-scopes", []string{"openid", "email", "groups", "profile"}, "OIDC scopes to request")
+	fs.StringVar(&o.OIDCDisplayName, "oidc-display-name", "SSO", "Name of the --oidc-issuer-url provider on the login page. Further providers are configured in the dashboard config")
+	fs.StringVar(&o.OIDCPostLogoutRedirectURL, "oidc-post-logout-redirect-url", "", "URL the identity provider redirects to after logout (e.g., https://dashboard.example.com/login)")
+	fs.BoolVar(&o.OIDCImpersonate, "oidc-impersonate", false, "Verify ID tokens in the dashboard and call Karmada with the dashboard's credentials, impersonating the user, for apiservers that do not trust the OIDC issuer")
+	fs.StringVar(&o.OIDCUsernameClaim, "oidc-username-claim", "email", "ID token claim used as the impersonated username when --oidc-impersonate is set")
+	fs.StringVar(&o.OIDCUsernamePrefix, "oidc-username-prefix", "", "Prefix for impersonated usernames, \"default:\" if empty and none if \"-\". Usernames starting with \"system:\" are never impersonated")
+	fs.StringVar(&o.OIDCGroupsClaim, "oidc-groups-claim", "groups", "ID token claim used as the impersonated groups when --oidc-impersonate is set")
+	fs.StringVar(&o.OIDCGroupsPrefix, "oidc-groups-prefix", "", "Prefix for impersonated groups, \"default:\" if empty and none if \"-\". Groups starting with \"system:\" are never impersonated")
+	fs.StringVar(&o.SessionSecret, "session-secret", "", "Secret used to encrypt OIDC session cookies, a random secret is generated if empty. Sessions are kept in the memory of the API server, so they end when it restarts and only work with a single replica")
+	fs.DurationVar(&o.SessionMaxAge, "session-max-age", 12*time.Hour, "Maximum lifetime of an OIDC session, after which the user logs in again")
+	fs.BoolVar(&o.SessionCookieSecure, "session-cookie-secure", true, "Only send the OIDC session cookie over HTTPS")
+
+	// Audit related flags
+	fs.StringVar(&o.AuditLogPath, "audit-log-path", "", "File mutating dashboard actions are appended to as JSON lines")
+	fs.BoolVar(&o.AuditLogStdout, "audit-log-stdout", false, "Write mutating dashboard actions to stdout as JSON lines")
+	fs.StringVar(&o.AuditWebhookURL, "audit-webhook-url", "", "URL each mutating dashboard action is posted to as JSON")
+	fs.DurationVar(&o.AuditWebhookTimeout, "audit-webhook-timeout", 5*time.Second, "Timeout for posting an action to --audit-webhook-url")
+	fs.IntVar(&o.AuditRecentEntries, "audit-recent-entries", 1000, "Number of recent actions kept in memory for the audit query endpoint")
 }
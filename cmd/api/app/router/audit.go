@@ -0,0 +1,300 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package router
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"hash"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/audit"
+	"github.com/karmada-io/dashboard/pkg/client"
+)
+
+const (
+	// auditUserTTL is how long the user the apiserver reported for a set of credentials is reused.
+	auditUserTTL = 5 * time.Minute
+	// maxAuditUsers bounds the cached users, the cache is pruned when it grows beyond.
+	maxAuditUsers = 1000
+	// maxAuditBodyPeek bounds the head of a request body read ahead of the handler to find its target.
+	maxAuditBodyPeek = 64 << 10
+)
+
+var (
+	// auditLogger records mutating requests, nil if auditing is not set up.
+	auditLogger *audit.Logger
+
+	auditUsersMu sync.Mutex
+	// auditUsers caches the user the apiserver authenticates a set of credentials as, by their digest.
+	auditUsers = map[string]cachedAuditUser{}
+
+	// unauditedRoutes are mutating routes not recorded per request. The sockjs transport of terminal sessions
+	// posts every keystroke, sessions are recorded when their shell is opened instead.
+	unauditedRoutes = map[string]bool{
+		"/api/v1/terminal/sockjs/*w": true,
+	}
+	// auditedReadRoutes are GET routes that are recorded all the same, as they open a shell in a container.
+	auditedReadRoutes = map[string]bool{
+		"/api/v1/terminal/pod/:namespace/:pod/shell/:container": true,
+	}
+)
+
+type cachedAuditUser struct {
+	user   audit.User
+	expiry time.Time
+}
+
+// SetAuditLogger makes AuditMiddleware record mutating requests to logger.
+func SetAuditLogger(logger *audit.Logger) {
+	auditLogger = logger
+}
+
+// AuditLogger returns the logger mutating requests are recorded to, nil if auditing is not set up.
+func AuditLogger() *audit.Logger {
+	return auditLogger
+}
+
+// AuditMiddleware records who sent every non-GET request, what it targeted and how it was answered.
+// Only a digest of the request body is recorded, bodies may carry secrets. The body is digested while the
+// handler reads it rather than buffered ahead of it, the digest covers the bytes read by the handler and at
+// least the first maxAuditBodyPeek bytes.
+func AuditMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		route := c.FullPath()
+		if auditLogger == nil || !isAuditedRoute(c.Request.Method, route) {
+			c.Next()
+			return
+		}
+
+		start := time.Now()
+		var peek []byte
+		digest := sha256.New()
+		if c.Request.Body != nil {
+			var err error
+			if peek, err = io.ReadAll(io.LimitReader(c.Request.Body, maxAuditBodyPeek)); err != nil {
+				klog.V(4).InfoS("Could not read request body for audit", "route", route, "err", err)
+			}
+			digest.Write(peek)
+			c.Request.Body = &auditedBody{
+				Reader: io.MultiReader(bytes.NewReader(peek), io.TeeReader(c.Request.Body, digest)),
+				body:   c.Request.Body,
+			}
+		}
+
+		c.Next()
+
+		entry := &audit.Entry{
+			Time:          start,
+			User:          auditUser(c.Request),
+			Verb:          auditVerb(c.Request.Method),
+			Method:        c.Request.Method,
+			Route:         route,
+			Path:          c.Request.URL.Path,
+			Target:        auditTarget(c, route, peek),
+			DryRun:        common.IsDryRun(c),
+			RequestDigest: bodyDigest(peek, digest),
+			ResponseCode:  c.Writer.Status(),
+			LatencyMillis: time.Since(start).Milliseconds(),
+			SourceIP:      c.ClientIP(),
+		}
+		if code, ok := c.Get(common.ResponseCodeKey); ok {
+			entry.ResponseCode = code.(int)
+		}
+		if err, ok := c.Get(common.ResponseErrorKey); ok {
+			entry.Error = err.(error).Error()
+		}
+		auditLogger.Record(entry)
+	}
+}
+
+// auditedBody serves a request body whose head was read ahead for the audit, digesting the rest as it is read.
+type auditedBody struct {
+	io.Reader
+	body io.Closer
+}
+
+func (b *auditedBody) Close() error {
+	return b.body.Close()
+}
+
+// bodyDigest formats digest like audit.Digest, empty for a request without a body.
+func bodyDigest(peek []byte, digest hash.Hash) string {
+	if len(peek) == 0 {
+		return ""
+	}
+	return hex.EncodeToString(digest.Sum(nil))
+}
+
+// RecordAudit records an action taken on behalf of c's request that AuditMiddleware does not see on its own,
+// e.g. a tool the assistant called. Time and User are filled in from the request if empty.
+func RecordAudit(c *gin.Context, entry *audit.Entry) {
+	if auditLogger == nil {
+		return
+	}
+	if entry.User.Username == "" {
+		entry.User = auditUser(c.Request)
+	}
+	if entry.SourceIP == "" {
+		entry.SourceIP = c.ClientIP()
+	}
+	auditLogger.Record(entry)
+}
+
+func isAuditedRoute(method, route string) bool {
+	// Unmatched requests are answered 404 without reaching any handler
+	if route == "" || unauditedRoutes[route] {
+		return false
+	}
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return auditedReadRoutes[route]
+	default:
+		return true
+	}
+}
+
+func auditVerb(method string) string {
+	switch method {
+	case http.MethodPost:
+		return "create"
+	case http.MethodPut:
+		return "update"
+	case http.MethodPatch:
+		return "patch"
+	case http.MethodDelete:
+		return "delete"
+	case http.MethodGet:
+		return "connect"
+	default:
+		return strings.ToLower(method)
+	}
+}
+
+// auditTarget takes the target from the route's parameters, and from the namespace and name fields most
+// request bodies carry for routes without them.
+func auditTarget(c *gin.Context, route string, body []byte) audit.Target {
+	target := audit.Target{
+		Cluster:   c.Param("clustername"),
+		Resource:  c.Param("kind"),
+		Namespace: c.Param("namespace"),
+		Name:      c.Param("name"),
+	}
+	if target.Resource == "" {
+		target.Resource = routeResource(route)
+	}
+	if target.Name == "" {
+		target.Name = c.Param("pod")
+	}
+	if target.Name == "" || target.Namespace == "" {
+		var fields struct {
+			Namespace         string `json:"namespace"`
+			Name              string `json:"name"`
+			MemberClusterName string `json:"memberClusterName"`
+		}
+		if json.Unmarshal(body, &fields) == nil {
+			if target.Namespace == "" {
+				target.Namespace = fields.Namespace
+			}
+			if target.Name == "" {
+				target.Name = fields.Name
+			}
+			if target.Name == "" {
+				target.Name = fields.MemberClusterName
+			}
+		}
+	}
+	return target
+}
+
+// routeResource returns the first segment of route below /api/v1 or /api/v1/member/:clustername.
+func routeResource(route string) string {
+	route = strings.TrimPrefix(route, "/api/v1/")
+	route = strings.TrimPrefix(route, "member/:clustername/")
+	resource, _, _ := strings.Cut(route, "/")
+	return resource
+}
+
+// auditUser returns who the Karmada apiserver authenticates request as. A SelfSubjectReview is sent the
+// first time a set of credentials is seen, the user is cached for auditUserTTL.
+func auditUser(request *http.Request) audit.User {
+	if user := client.ImpersonatedUserFromRequest(request); user != nil {
+		return audit.User{Username: user.Name, Groups: user.Groups, Impersonated: true}
+	}
+	if !client.HasAuthorizationHeader(request) {
+		return audit.User{}
+	}
+
+	key := credentialsDigest(request)
+	now := time.Now()
+	auditUsersMu.Lock()
+	cached, ok := auditUsers[key]
+	auditUsersMu.Unlock()
+	if ok && now.Before(cached.expiry) {
+		return cached.user
+	}
+
+	kubeClient, err := client.GetKarmadaClientFromRequestForKarmadaAPIServer(request)
+	if err != nil {
+		klog.V(4).InfoS("Could not identify user for audit", "err", err)
+		return audit.User{}
+	}
+	userInfo, err := client.ReviewSelf(request.Context(), kubeClient)
+	if err != nil {
+		klog.V(4).InfoS("Could not identify user for audit", "err", err)
+		return audit.User{}
+	}
+	user := audit.User{Username: userInfo.Username, Groups: userInfo.Groups}
+
+	auditUsersMu.Lock()
+	defer auditUsersMu.Unlock()
+	if len(auditUsers) >= maxAuditUsers {
+		for k, v := range auditUsers {
+			if now.After(v.expiry) {
+				delete(auditUsers, k)
+			}
+		}
+		if len(auditUsers) >= maxAuditUsers {
+			auditUsers = map[string]cachedAuditUser{}
+		}
+	}
+	auditUsers[key] = cachedAuditUser{user: user, expiry: now.Add(auditUserTTL)}
+	return user
+}
+
+// credentialsDigest digests the headers that decide who a request is authenticated as.
+func credentialsDigest(request *http.Request) string {
+	var headers []string
+	for name, values := range request.Header {
+		if name == "Authorization" || strings.HasPrefix(name, "Impersonate-") {
+			headers = append(headers, name+": "+strings.Join(values, ","))
+		}
+	}
+	sort.Strings(headers)
+	return audit.Digest([]byte(strings.Join(headers, "\n")))
+}
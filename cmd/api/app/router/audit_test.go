@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package router
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/audit"
+	"github.com/karmada-io/dashboard/pkg/client"
+)
+
+func TestAuditMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	logger := audit.NewLogger(10)
+	SetAuditLogger(logger)
+	defer SetAuditLogger(nil)
+
+	r := gin.New()
+	r.Use(AuditMiddleware())
+	r.Use(func(c *gin.Context) {
+		c.Request = client.WithImpersonatedUser(c.Request, &client.ImpersonatedUser{Name: "alice", Groups: []string{"dev"}})
+	})
+	r.GET("/api/v1/cluster/:name", func(c *gin.Context) {
+		common.Success(c, nil)
+	})
+	r.DELETE("/api/v1/propagationpolicy", func(c *gin.Context) {
+		common.Fail(c, errors.New("forbidden"))
+	})
+	r.DELETE("/api/v1/member/:clustername/_raw/:kind/namespace/:namespace/name/:name", func(c *gin.Context) {
+		common.Success(c, nil)
+	})
+
+	requests := []*http.Request{
+		httptest.NewRequest(http.MethodGet, "/api/v1/cluster/member1", nil),
+		httptest.NewRequest(http.MethodDelete, "/api/v1/propagationpolicy?dryRun=true",
+			strings.NewReader(`{"namespace":"default","name":"nginx"}`)),
+		httptest.NewRequest(http.MethodDelete, "/api/v1/member/member1/_raw/deployment/namespace/default/name/nginx", nil),
+	}
+	for _, req := range requests {
+		r.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	entries := logger.Query(audit.Query{})
+	if len(entries) != 2 {
+		t.Fatalf("recorded %d entries, want 2 without the GET", len(entries))
+	}
+	raw, policy := entries[0], entries[1]
+
+	wantTarget := audit.Target{Cluster: "member1", Resource: "deployment", Namespace: "default", Name: "nginx"}
+	if raw.Target != wantTarget {
+		t.Errorf("raw target = %+v, want %+v", raw.Target, wantTarget)
+	}
+	if raw.Verb != "delete" || raw.ResponseCode != 200 || raw.RequestDigest != "" {
+		t.Errorf("raw entry = %+v", raw)
+	}
+
+	wantTarget = audit.Target{Resource: "propagationpolicy", Namespace: "default", Name: "nginx"}
+	if policy.Target != wantTarget {
+		t.Errorf("policy target = %+v, want %+v", policy.Target, wantTarget)
+	}
+	if policy.ResponseCode != 500 || policy.Error != "forbidden" || !policy.DryRun {
+		t.Errorf("policy entry = %+v, want a failed dry run", policy)
+	}
+	if policy.RequestDigest == "" {
+		t.Errorf("policy entry has no request digest")
+	}
+	if policy.User.Username != "alice" || !policy.User.Impersonated {
+		t.Errorf("policy user = %+v, want impersonated alice", policy.User)
+	}
+}
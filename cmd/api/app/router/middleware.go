@@ -18,6 +18,7 @@ package router
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -25,32 +26,51 @@ import (
 	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kubeclient "k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
 
 	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
 	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/oidc"
 )
 
 const (
 	karmadaClientContextKey = "karmadaClient"
 	kubeClientContextKey    = "kubeClient"
+	memberClientContextKey  = "memberClient"
 )
 
+var (
+	// sessionManager resolves OIDC session cookies, nil if OIDC is not configured.
+	sessionManager *oidc.SessionManager
+	// oidcProviders maps ID tokens of providers in impersonation mode to the user to impersonate.
+	oidcProviders *oidc.Registry
+)
+
+// SetOIDC makes AuthMiddleware accept OIDC session cookies in place of an Authorization header, and
+// impersonate the users of providers in impersonation mode.
+func SetOIDC(sessions *oidc.SessionManager, providers *oidc.Registry) {
+	sessionManager = sessions
+	oidcProviders = providers
+}
+
 func isAnonymousPath(path string) bool {
 	switch path {
-	case "/api/v1/auth/oidc/enabled", "/api/v1/auth/oidc/login", "/api/v1/auth/oidc/callback":
+	case "/api/v1/auth/oidc/enabled", "/api/v1/auth/oidc/login", "/api/v1/auth/oidc/callback", "/api/v1/auth/logout":
 		return true
-	case "/auth/oidc/enabled", "/auth/oidc/login", "/auth/oidc/callback":
+	case "/auth/oidc/enabled", "/auth/oidc/login", "/auth/oidc/callback", "/auth/logout":
 		return true
 	default:
 		return false
 	}
 }
 
-// EnsureMemberClusterMiddleware ensures that the member cluster exists.
+// EnsureMemberClusterMiddleware ensures that the member cluster exists and is visible to the caller.
 func EnsureMemberClusterMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		karmadaClient := client.InClusterKarmadaClient()
-		_, err := karmadaClient.ClusterV1alpha1().Clusters().Get(context.TODO(), c.Param("clustername"), metav1.GetOptions{})
+		karmadaClient, err := GetKarmadaClientFromContext(c)
+		if err == nil {
+			_, err = karmadaClient.ClusterV1alpha1().Clusters().Get(context.TODO(), c.Param("clustername"), metav1.GetOptions{})
+		}
 		if err != nil {
 			c.AbortWithStatusJSON(http.StatusOK, common.BaseResponse{
 				Code: 500,
@@ -62,7 +82,9 @@ func EnsureMemberClusterMiddleware() gin.HandlerFunc {
 	}
 }
 
-// AuthMiddleware checks if the request has an Authorization header.
+// AuthMiddleware checks if the request has an Authorization header. Requests without one are
+// authenticated by their OIDC session cookie, whose ID token is refreshed as it expires.
+// ID tokens of providers in impersonation mode are verified here and the user they map to is impersonated.
 func AuthMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		if isAnonymousPath(c.Request.URL.Path) {
@@ -70,7 +92,7 @@ func AuthMiddleware() gin.HandlerFunc {
 			return
 		}
 
-		if c.Request.Header.Get("Authorization") == "" && c.Query("Authorization") == "" {
+		if c.Request.Header.Get("Authorization") == "" && c.Query("Authorization") == "" && !setSessionAuthorization(c) {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, common.BaseResponse{
 				Code: http.StatusUnauthorized,
 				Msg:  "Forbidden",
@@ -80,10 +102,57 @@ func AuthMiddleware() gin.HandlerFunc {
 		if c.Request.Header.Get("Authorization") == "" && c.Query("Authorization") != "" {
 			c.Request.Header.Set("Authorization", c.Query("Authorization"))
 		}
+		if err := impersonateOIDCUser(c); err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, common.BaseResponse{
+				Code: http.StatusUnauthorized,
+				Msg:  err.Error(),
+			})
+			return
+		}
 		c.Next()
 	}
 }
 
+// setSessionAuthorization sets the Authorization header from the request's session cookie, if it has a valid one.
+func setSessionAuthorization(c *gin.Context) bool {
+	if sessionManager == nil {
+		return false
+	}
+	if _, err := c.Cookie(oidc.SessionCookieName); err != nil {
+		return false
+	}
+	idToken, err := sessionManager.IDToken(c.Request.Context(), c.Request)
+	if err != nil {
+		if errors.Is(err, oidc.ErrNoIDTokenOnRefresh) {
+			// the identity provider cannot extend any session, operators need to know about it
+			klog.ErrorS(err, "Could not refresh OIDC session, the user has to log in again")
+		} else {
+			klog.V(4).InfoS("Could not use OIDC session", "err", err)
+		}
+		http.SetCookie(c.Writer, sessionManager.ExpiredCookie())
+		return false
+	}
+	client.SetAuthorizationHeader(c.Request, idToken)
+	return true
+}
+
+// impersonateOIDCUser makes a request carrying an ID token of a provider in impersonation mode reach
+// Karmada with the dashboard's own credentials, impersonating the user the token's claims map to.
+func impersonateOIDCUser(c *gin.Context) error {
+	if oidcProviders == nil {
+		return nil
+	}
+	identity, err := oidcProviders.Identity(c.Request.Context(), client.GetBearerToken(c.Request))
+	if err != nil || identity == nil {
+		return err
+	}
+	c.Request = client.WithImpersonatedUser(c.Request, &client.ImpersonatedUser{
+		Name:   identity.Username,
+		Groups: identity.Groups,
+	})
+	return nil
+}
+
 // ClientMiddleware fetches Karmada and Kubernetes clients and stores them in the context.
 func ClientMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -107,6 +176,21 @@ func ClientMiddleware() gin.HandlerFunc {
 	}
 }
 
+// MemberClientMiddleware stores a Kubernetes client for the member cluster in the path in the context.
+// The client acts with the caller's bearer token and impersonation headers through the karmada cluster proxy.
+func MemberClientMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		memberClient, err := client.ClientForMemberClusterFromRequest(c.Request, c.Param("clustername"))
+		if err != nil {
+			common.Fail(c, err)
+			c.Abort()
+			return
+		}
+		c.Set(memberClientContextKey, memberClient)
+		c.Next()
+	}
+}
+
 // GetKarmadaClientFromContext retrieves the Karmada client from the Gin context.
 func GetKarmadaClientFromContext(c *gin.Context) (karmadaclientset.Interface, error) {
 	val, exists := c.Get(karmadaClientContextKey)
@@ -132,3 +216,16 @@ func GetKubeClientFromContext(c *gin.Context) (kubeclient.Interface, error) {
 	}
 	return kClient, nil
 }
+
+// GetMemberClientFromContext retrieves the Kubernetes client for the member cluster in the path from the Gin context.
+func GetMemberClientFromContext(c *gin.Context) (kubeclient.Interface, error) {
+	val, exists := c.Get(memberClientContextKey)
+	if !exists {
+		return nil, fmt.Errorf("member cluster client not found in context")
+	}
+	kClient, ok := val.(kubeclient.Interface)
+	if !ok {
+		return nil, fmt.Errorf("member cluster client type assertion failed")
+	}
+	return kClient, nil
+}
@@ -35,11 +35,14 @@ func init() {
 
 	router = gin.Default()
 	_ = router.SetTrustedProxies(nil)
+	// Ahead of every route, so that requests refused by AuthMiddleware are recorded too
+	router.Use(AuditMiddleware())
 	v1 = router.Group("/api/v1")
 	v1.Use(AuthMiddleware())
 	v1.Use(ClientMiddleware())
 	member = v1.Group("/member/:clustername")
 	member.Use(EnsureMemberClusterMiddleware())
+	member.Use(MemberClientMiddleware())
 
 	router.GET("/livez", func(c *gin.Context) {
 		c.String(200, "livez")
@@ -0,0 +1,80 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aggregated
+
+import (
+	"github.com/gin-gonic/gin"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/resource/aggregated"
+)
+
+// memberClientsFor returns the aggregated.ClientForCluster of a request, member clusters are reached with the
+// caller's credentials.
+func memberClientsFor(c *gin.Context) aggregated.ClientForCluster {
+	return func(clusterName string) (kubernetes.Interface, error) {
+		return client.ClientForMemberClusterFromRequest(c.Request, clusterName)
+	}
+}
+
+// handleGetAggregatedPod lists pods of all member clusters, or of those matching the clusterSelector query.
+func handleGetAggregatedPod(c *gin.Context) {
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	dataSelect := common.ParseDataSelectPathParameter(c)
+	nsQuery := common.ParseNamespacePathParameter(c)
+	result, err := aggregated.GetPodList(c.Request.Context(), karmadaClient, memberClientsFor(c), c.Query("clusterSelector"), nsQuery, dataSelect)
+	if err != nil {
+		klog.ErrorS(err, "Get aggregated pod list failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+// handleGetAggregatedDeployment lists deployments of all member clusters, or of those matching the clusterSelector query.
+func handleGetAggregatedDeployment(c *gin.Context) {
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	dataSelect := common.ParseDataSelectPathParameter(c)
+	nsQuery := common.ParseNamespacePathParameter(c)
+	result, err := aggregated.GetDeploymentList(c.Request.Context(), karmadaClient, memberClientsFor(c), c.Query("clusterSelector"), nsQuery, dataSelect)
+	if err != nil {
+		klog.ErrorS(err, "Get aggregated deployment list failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func init() {
+	r := router.V1()
+	r.GET("/aggregated/pod", handleGetAggregatedPod)
+	r.GET("/aggregated/pod/:namespace", handleGetAggregatedPod)
+	r.GET("/aggregated/deployment", handleGetAggregatedDeployment)
+	r.GET("/aggregated/deployment/:namespace", handleGetAggregatedDeployment)
+}
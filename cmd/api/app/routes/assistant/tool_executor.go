@@ -19,12 +19,16 @@ package assistant
 import (
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/sashabaranov/go-openai"
 	"k8s.io/klog/v2"
 
+	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	"github.com/karmada-io/dashboard/pkg/audit"
 	"github.com/karmada-io/dashboard/pkg/mcpclient"
 )
 
@@ -56,7 +60,9 @@ func executeTool(toolCall *openai.ToolCall, mcpClient *mcpclient.MCPClient, c *g
 		klog.Errorf("Failed to send tool call start event: %v", err)
 	}
 
+	start := time.Now()
 	result, err := mcpClient.CallTool(toolName, args)
+	auditToolCall(c, toolName, toolCall.Function.Arguments, args, start, err)
 	if err != nil {
 		klog.Errorf("Failed to execute tool %s: %v", toolName, err)
 		result = fmt.Sprintf("Error executing tool %s: %v", toolName, err)
@@ -82,6 +88,28 @@ func executeTool(toolCall *openai.ToolCall, mcpClient *mcpclient.MCPClient, c *g
 	}
 }
 
+// auditToolCall records a tool the assistant called on behalf of the user, the chat request itself only shows
+// that the user talked to the assistant.
+func auditToolCall(c *gin.Context, toolName, rawArgs string, args map[string]interface{}, start time.Time, err error) {
+	entry := &audit.Entry{
+		Time:          start,
+		Verb:          "call",
+		Route:         "mcp/" + toolName,
+		RequestDigest: audit.Digest([]byte(rawArgs)),
+		ResponseCode:  http.StatusOK,
+		LatencyMillis: time.Since(start).Milliseconds(),
+	}
+	entry.Target.Cluster, _ = args["cluster"].(string)
+	entry.Target.Resource, _ = args["resource"].(string)
+	entry.Target.Namespace, _ = args["namespace"].(string)
+	entry.Target.Name, _ = args["name"].(string)
+	if err != nil {
+		entry.ResponseCode = http.StatusInternalServerError
+		entry.Error = err.Error()
+	}
+	router.RecordAudit(c, entry)
+}
+
 // accumulateToolCalls accumulates streamed tool call chunks into the buffer
 func accumulateToolCalls(toolCalls []openai.ToolCall, toolCallBuffer map[int]*openai.ToolCall) {
 	for _, toolCall := range toolCalls {
@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/audit"
+	"github.com/karmada-io/dashboard/pkg/client"
+)
+
+const (
+	// readerPath is the non-resource URL a caller must be allowed to get on the Karmada apiserver to read the
+	// entries of every user, e.g. with a ClusterRole listing it in nonResourceURLs. Others read their own.
+	readerPath = "/api/v1/audit"
+	// defaultLimit caps the entries returned when the query does not.
+	defaultLimit = 100
+)
+
+func handleGetAudit(c *gin.Context) {
+	logger := router.AuditLogger()
+	if logger == nil {
+		common.Fail(c, fmt.Errorf("audit log is not enabled"))
+		return
+	}
+	request := new(v1.AuditQueryRequest)
+	if err := c.ShouldBindQuery(request); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	kubeClient, err := router.GetKubeClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+
+	query := audit.Query{
+		Username:  request.User,
+		Verb:      request.Verb,
+		Resource:  request.Resource,
+		Cluster:   request.Cluster,
+		Namespace: request.Namespace,
+		Since:     request.Since,
+		Limit:     request.Limit,
+	}
+	if query.Limit == 0 {
+		query.Limit = defaultLimit
+	}
+
+	review, err := kubeClient.AuthorizationV1().SelfSubjectAccessReviews().Create(c.Request.Context(), &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			NonResourceAttributes: &authorizationv1.NonResourceAttributes{Path: readerPath, Verb: "get"},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		klog.ErrorS(err, "Could not review access to the audit log")
+		common.Fail(c, err)
+		return
+	}
+	ownEntriesOnly := !review.Status.Allowed
+	if ownEntriesOnly {
+		userInfo, err := client.ReviewSelf(c.Request.Context(), kubeClient)
+		if err != nil {
+			common.Fail(c, err)
+			return
+		}
+		query.Username = userInfo.Username
+	}
+
+	common.Success(c, v1.AuditQueryResponse{
+		Entries:        logger.Query(query),
+		OwnEntriesOnly: ownEntriesOnly,
+	})
+}
+
+func init() {
+	router.V1().GET("/audit", handleGetAudit)
+}
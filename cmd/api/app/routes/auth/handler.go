@@ -17,6 +17,10 @@ limitations under the License.
 package auth
 
 import (
+	"fmt"
+	"slices"
+	"strings"
+
 	"github.com/gin-gonic/gin"
 	"k8s.io/klog/v2"
 
@@ -48,6 +52,23 @@ func handleMe(c *gin.Context) {
 		return
 	}
 
+	var namespaces []string
+	for _, namespace := range strings.Split(c.Query("namespaces"), ",") {
+		if namespace = strings.TrimSpace(namespace); namespace != "" && !slices.Contains(namespaces, namespace) {
+			namespaces = append(namespaces, namespace)
+		}
+	}
+	if len(namespaces) > maxPermissionNamespaces {
+		common.Fail(c, fmt.Errorf("permissions can be reviewed in at most %d namespaces, got %d", maxPermissionNamespaces, len(namespaces)))
+		return
+	}
+	response.Permissions, _, err = permissions(c.Request, namespaces)
+	if err != nil {
+		klog.ErrorS(err, "Could not get user permissions")
+		common.Fail(c, err)
+		return
+	}
+
 	common.Success(c, response)
 }
 
@@ -59,4 +80,5 @@ func init() {
 	router.Router().GET("/api/v1/auth/oidc/enabled", handleOIDCEnabled)
 	router.Router().GET("/api/v1/auth/oidc/login", handleOIDCLogin)
 	router.Router().GET("/api/v1/auth/oidc/callback", handleOIDCCallback)
+	router.Router().POST("/api/v1/auth/logout", handleLogout)
 }
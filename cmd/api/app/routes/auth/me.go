@@ -18,20 +18,28 @@ package auth
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeclient "k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
 
 	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
 	"github.com/karmada-io/dashboard/pkg/client"
 	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/resource/permission"
 )
 
 const (
 	tokenServiceAccountKey = "serviceaccount"
+
+	// maxPermissionNamespaces caps the namespaces permissions are reviewed in for a single request.
+	maxPermissionNamespaces = 20
 )
 
 // GetCurrentUser returns the current user from the context .
@@ -40,19 +48,62 @@ func GetCurrentUser(c *gin.Context) (*v1.User, int, error) {
 }
 
 func me(request *http.Request) (*v1.User, int, error) {
-	karmadaClient, err := client.GetKarmadaClientFromRequest(request)
+	kubeClient, err := client.GetKarmadaClientFromRequestForKarmadaAPIServer(request)
 	if err != nil {
 		code, err := errors.HandleError(err)
 		return nil, code, err
 	}
 
-	// Make sure that authorization token is valid
-	if _, err = karmadaClient.Discovery().ServerVersion(); err != nil {
+	// The review fails unless the apiserver accepts the authorization token
+	userInfo, err := client.ReviewSelf(request.Context(), kubeClient)
+	if err != nil {
+		code, err := errors.HandleError(err)
+		return nil, code, err
+	}
+
+	// The token's claims are only used for display, the identity is the one the apiserver reports
+	user := getUserFromToken(client.GetBearerToken(request))
+	user.Username = userInfo.Username
+	user.UID = userInfo.UID
+	user.Groups = userInfo.Groups
+	if len(userInfo.Extra) > 0 {
+		user.Extra = make(map[string][]string, len(userInfo.Extra))
+		for key, value := range userInfo.Extra {
+			user.Extra[key] = value
+		}
+	}
+	if user.Name == "" {
+		user.Name = userInfo.Username
+	}
+	return user, http.StatusOK, nil
+}
+
+// permissions returns what the request's user may do with the key resources in namespaces. Without namespaces
+// the first namespaces the user can list are reviewed.
+func permissions(request *http.Request, namespaces []string) (*permission.Matrix, int, error) {
+	kubeClient, err := client.GetKarmadaClientFromRequestForKarmadaAPIServer(request)
+	if err != nil {
 		code, err := errors.HandleError(err)
 		return nil, code, err
 	}
 
-	return getUserFromToken(client.GetBearerToken(request)), http.StatusOK, nil
+	if len(namespaces) == 0 {
+		namespaces = listableNamespaces(request.Context(), kubeClient)
+	}
+	return permission.GetMatrix(request.Context(), kubeClient, namespaces), http.StatusOK, nil
+}
+
+func listableNamespaces(ctx context.Context, kubeClient kubeclient.Interface) []string {
+	list, err := kubeClient.CoreV1().Namespaces().List(ctx, metav1.ListOptions{Limit: maxPermissionNamespaces})
+	if err != nil || len(list.Items) == 0 {
+		klog.V(4).InfoS("Could not list namespaces, reviewing permissions in the default namespace", "err", err)
+		return []string{metav1.NamespaceDefault}
+	}
+	namespaces := make([]string, 0, len(list.Items))
+	for _, namespace := range list.Items {
+		namespaces = append(namespaces, namespace.Name)
+	}
+	return namespaces
 }
 
 func getUserFromToken(token string) *v1.User {
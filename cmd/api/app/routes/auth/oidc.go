@@ -18,32 +18,108 @@ package auth
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/klog/v2"
 
+	"github.com/karmada-io/dashboard/cmd/api/app/router"
 	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
 	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/config"
 	oidcpkg "github.com/karmada-io/dashboard/pkg/oidc"
 )
 
-var oidcProvider *oidcpkg.Provider
+// oidcSyncPeriod is how often the providers are brought in line with the dashboard config.
+const oidcSyncPeriod = 15 * time.Second
 
-// InitOIDCProvider initializes the OIDC provider
-func InitOIDCProvider(ctx context.Context, cfg *oidcpkg.Config) error {
-	provider, err := oidcpkg.NewProvider(ctx, cfg)
+var (
+	oidcProviders *oidcpkg.Registry
+	sessions      *oidcpkg.SessionManager
+	// flagProvider is the provider configured by the --oidc-* flags, nil if there is none.
+	flagProvider *oidcpkg.Config
+)
+
+// InitOIDC initializes the OIDC providers and the sessions of users logged in through them. cfg is the
+// provider configured by flags, nil if there is none; further providers come from the dashboard config.
+func InitOIDC(ctx context.Context, cfg *oidcpkg.Config, sessionCfg *oidcpkg.SessionConfig) error {
+	registry := oidcpkg.NewRegistry(ctx)
+	sessionManager, err := oidcpkg.NewSessionManager(registry, sessionCfg)
 	if err != nil {
 		return err
 	}
-	oidcProvider = provider
-	klog.InfoS("OIDC provider initialized successfully", "issuer", cfg.IssuerURL)
+	oidcProviders = registry
+	sessions = sessionManager
+	flagProvider = cfg
+	router.SetOIDC(sessionManager, registry)
+
+	if cfg != nil {
+		if errs := syncOIDCProviders(ctx); len(errs) > 0 {
+			return errs[0]
+		}
+		klog.InfoS("OIDC provider initialized successfully", "issuer", cfg.IssuerURL)
+	}
+	// The dashboard config may change at runtime, providers are synced in the background so that
+	// discovery never runs on a request path.
+	go wait.UntilWithContext(ctx, func(ctx context.Context) {
+		syncOIDCProviders(ctx)
+	}, oidcSyncPeriod)
 	return nil
 }
 
-// handleOIDCLogin initiates the OIDC login flow
+// syncOIDCProviders brings the providers in line with the flags and the dashboard config. Unchanged
+// providers are reused, so this is cheap.
+func syncOIDCProviders(ctx context.Context) []error {
+	var configs []oidcpkg.Config
+	var errs []error
+	if flagProvider != nil {
+		configs = append(configs, *flagProvider)
+	}
+	for _, p := range config.GetOIDCProviders() {
+		var clientSecret string
+		if p.ClientSecretRef != nil {
+			secret, err := config.GetSecretKey(ctx, client.InClusterClient(), p.ClientSecretRef)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("OIDC provider %q: read client secret: %w", p.ID, err))
+				continue
+			}
+			clientSecret = secret
+		}
+		var impersonation *oidcpkg.ImpersonationConfig
+		if p.Impersonation != nil {
+			impersonation = &oidcpkg.ImpersonationConfig{
+				UsernameClaim:  p.Impersonation.UsernameClaim,
+				UsernamePrefix: p.Impersonation.UsernamePrefix,
+				GroupsClaim:    p.Impersonation.GroupsClaim,
+				GroupsPrefix:   p.Impersonation.GroupsPrefix,
+			}
+		}
+		configs = append(configs, oidcpkg.Config{
+			ID:                    p.ID,
+			DisplayName:           p.DisplayName,
+			IssuerURL:             p.IssuerURL,
+			ClientID:              p.ClientID,
+			ClientSecret:          clientSecret,
+			RedirectURL:           p.RedirectURL,
+			Scopes:                p.Scopes,
+			PostLogoutRedirectURL: p.PostLogoutRedirectURL,
+			Impersonation:         impersonation,
+		})
+	}
+	errs = append(errs, oidcProviders.Sync(configs)...)
+	for _, err := range errs {
+		klog.ErrorS(err, "OIDC provider is unavailable")
+	}
+	return errs
+}
+
+// handleOIDCLogin initiates the OIDC login flow with the provider chosen by the provider query parameter
 func handleOIDCLogin(c *gin.Context) {
-	if oidcProvider == nil {
+	if len(oidcProviders.List()) == 0 {
 		klog.Warning("OIDC login requested but OIDC is not configured")
 		c.JSON(http.StatusNotImplemented, gin.H{
 			"code":    http.StatusNotImplemented,
@@ -52,7 +128,14 @@ func handleOIDCLogin(c *gin.Context) {
 		return
 	}
 
-	authURL, state, err := oidcProvider.GenerateAuthURL()
+	var req v1.OIDCLoginRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		klog.ErrorS(err, "Failed to bind OIDC login request")
+		common.Fail(c, err)
+		return
+	}
+
+	authURL, state, err := oidcProviders.GenerateAuthURL(req.Provider)
 	if err != nil {
 		klog.ErrorS(err, "Failed to generate OIDC auth URL")
 		common.Fail(c, err)
@@ -67,25 +150,18 @@ func handleOIDCLogin(c *gin.Context) {
 	common.Success(c, response)
 }
 
-// handleOIDCEnabled returns whether OIDC is enabled on this server.
+// handleOIDCEnabled returns whether OIDC is enabled on this server, and the providers users can choose from.
 func handleOIDCEnabled(c *gin.Context) {
+	providers := oidcProviders.List()
 	response := &v1.OIDCEnabledResponse{
-		Enabled: oidcProvider != nil,
+		Enabled:   len(providers) > 0,
+		Providers: providers,
 	}
 	common.Success(c, response)
 }
 
 // handleOIDCCallback handles the OIDC callback
 func handleOIDCCallback(c *gin.Context) {
-	if oidcProvider == nil {
-		klog.Warning("OIDC callback received but OIDC is not configured")
-		c.JSON(http.StatusNotImplemented, gin.H{
-			"code":    http.StatusNotImplemented,
-			"message": "OIDC authentication is not configured on this server",
-		})
-		return
-	}
-
 	var req v1.OIDCCallbackRequest
 	if err := c.ShouldBindQuery(&req); err != nil {
 		klog.ErrorS(err, "Failed to bind OIDC callback request")
@@ -93,8 +169,12 @@ func handleOIDCCallback(c *gin.Context) {
 		return
 	}
 
-	// Validate state parameter
-	if err := oidcProvider.ValidateState(req.State); err != nil {
+	// Validate state parameter, it names the provider the login was started with
+	provider, err := oidcProviders.ProviderForState(req.State)
+	if err == nil {
+		err = provider.ValidateState(req.State)
+	}
+	if err != nil {
 		klog.ErrorS(err, "Invalid state parameter")
 		c.JSON(http.StatusBadRequest, gin.H{
 			"code":    http.StatusBadRequest,
@@ -105,26 +185,15 @@ func handleOIDCCallback(c *gin.Context) {
 
 	// Exchange authorization code for tokens
 	ctx := c.Request.Context()
-	token, err := oidcProvider.ExchangeToken(ctx, req.Code)
+	token, err := provider.ExchangeToken(ctx, req.Code, req.State)
 	if err != nil {
 		klog.ErrorS(err, "Failed to exchange authorization code")
 		common.Fail(c, err)
 		return
 	}
 
-	// Extract ID token
-	rawIDToken, ok := token.Extra("id_token").(string)
-	if !ok {
-		klog.Error("No id_token in token response")
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"code":    http.StatusInternalServerError,
-			"message": "No id_token in token response",
-		})
-		return
-	}
-
 	// Verify ID token
-	_, err = oidcProvider.VerifyIDToken(ctx, rawIDToken)
+	tokens, err := provider.VerifyTokens(ctx, token)
 	if err != nil {
 		klog.ErrorS(err, "Failed to verify ID token")
 		c.JSON(http.StatusUnauthorized, gin.H{
@@ -134,10 +203,31 @@ func handleOIDCCallback(c *gin.Context) {
 		return
 	}
 
-	// Return the ID token to the client
+	// Keep the tokens server-side, the browser only gets the session cookie
+	cookie, err := sessions.Create(tokens)
+	if err != nil {
+		klog.ErrorS(err, "Failed to create session")
+		common.Fail(c, err)
+		return
+	}
+	http.SetCookie(c.Writer, cookie)
+
 	response := &v1.OIDCCallbackResponse{
-		Token: rawIDToken,
+		Authenticated: true,
 	}
 
 	common.Success(c, response)
 }
+
+// handleLogout revokes the OIDC session and clears its cookie. The response carries the identity
+// provider's end_session URL when discovery exposes one, so the browser can end the IdP session too.
+func handleLogout(c *gin.Context) {
+	response := &v1.LogoutResponse{}
+	if tokens := sessions.Revoke(c.Request); tokens != nil {
+		if provider, err := oidcProviders.Get(tokens.ProviderID); err == nil {
+			response.EndSessionURL = provider.EndSessionURL(tokens.IDToken)
+		}
+	}
+	http.SetCookie(c.Writer, sessions.ExpiredCookie())
+	common.Success(c, response)
+}
@@ -18,22 +18,34 @@ package cluster
 
 import (
 	"context"
+	_ "crypto/sha256" // Registers sha256 for the digests of image references
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
+	"github.com/distribution/reference"
 	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
 	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
 	cmdutil "github.com/karmada-io/karmada/pkg/karmadactl/util"
 	karmadautil "github.com/karmada-io/karmada/pkg/util"
+	"github.com/karmada-io/karmada/pkg/util/names"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
 	kubeclient "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	"github.com/karmada-io/dashboard/pkg/config"
 )
 
 const (
@@ -43,29 +55,91 @@ const (
 	KarmadaAgentServiceAccountName = "karmada-agent-sa"
 	// KarmadaAgentName is the name of karmada-agent
 	KarmadaAgentName = "karmada-agent"
-	// KarmadaAgentImage is the image of karmada-agent
-	KarmadaAgentImage = "karmada/karmada-agent:latest"
 	// ClusterNamespace is the namespace of cluster
 	ClusterNamespace = "karmada-cluster"
 )
 
 var (
-	karmadaAgentLabels   = map[string]string{"app": KarmadaAgentName}
-	karmadaAgentReplicas = int32(2)
-	timeout              = 5 * time.Minute
+	karmadaAgentLabels = map[string]string{"app": KarmadaAgentName}
+	timeout            = 5 * time.Minute
 )
 
 type pullModeOption struct {
 	karmadaClient          karmadaclientset.Interface
 	karmadaAgentCfg        *clientcmdapi.Config
 	memberClusterNamespace string
-	memberClusterClient    *kubeclient.Clientset
+	memberClusterClient    kubeclient.Interface
 	memberClusterName      string
 	memberClusterEndpoint  string
+	clusterProvider        string
+	clusterRegion          string
+	clusterZones           []string
+	agent                  config.KarmadaAgentConfig
 }
 
-// createSecretAndRBACInMemberCluster create required secrets and rbac in member cluster
-func (o pullModeOption) createSecretAndRBACInMemberCluster() error {
+// karmadaAgentConfigFromRequest overlays the karmada-agent options of a join request on the dashboard config defaults.
+func karmadaAgentConfigFromRequest(opts *v1.KarmadaAgentOptions) config.KarmadaAgentConfig {
+	agent := config.GetKarmadaAgentConfig()
+	if opts == nil {
+		return agent
+	}
+	if opts.Image != "" {
+		agent.Image = opts.Image
+	}
+	if opts.Version != "" {
+		agent.Version = opts.Version
+	}
+	if opts.ImagePullSecrets != nil {
+		agent.ImagePullSecrets = opts.ImagePullSecrets
+	}
+	if opts.Replicas != nil {
+		agent.Replicas = *opts.Replicas
+	}
+	if opts.ResourceRequests != nil {
+		agent.Resources.Requests = opts.ResourceRequests
+	}
+	if opts.ResourceLimits != nil {
+		agent.Resources.Limits = opts.ResourceLimits
+	}
+	if opts.FeatureGates != nil {
+		agent.FeatureGates = opts.FeatureGates
+	}
+	if opts.ProxyServerAddress != "" {
+		agent.ProxyServerAddress = opts.ProxyServerAddress
+	}
+	if opts.HTTPProxy != "" {
+		agent.HTTPProxy = opts.HTTPProxy
+	}
+	if opts.HTTPSProxy != "" {
+		agent.HTTPSProxy = opts.HTTPSProxy
+	}
+	if opts.NoProxy != "" {
+		agent.NoProxy = opts.NoProxy
+	}
+	if opts.LogLevel != nil {
+		agent.LogLevel = opts.LogLevel
+	}
+	return agent
+}
+
+// parseResourceList converts resource quantities written as strings into a ResourceList.
+func parseResourceList(quantities map[string]string) (corev1.ResourceList, error) {
+	if len(quantities) == 0 {
+		return nil, nil
+	}
+	list := make(corev1.ResourceList, len(quantities))
+	for name, value := range quantities {
+		quantity, err := resource.ParseQuantity(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid karmada-agent resource %s=%q: %w", name, value, err)
+		}
+		list[corev1.ResourceName(name)] = quantity
+	}
+	return list, nil
+}
+
+// createSecretInMemberCluster create the karmada kubeconfig secret used by karmada-agent in member cluster
+func (o pullModeOption) createSecretInMemberCluster() error {
 	configBytes, err := clientcmd.Write(*o.karmadaAgentCfg)
 	if err != nil {
 		return fmt.Errorf("failure while serializing karmada-agent kubeConfig. %w", err)
@@ -88,7 +162,11 @@ func (o pullModeOption) createSecretAndRBACInMemberCluster() error {
 	if err := cmdutil.CreateOrUpdateSecret(o.memberClusterClient, kubeConfigSecret); err != nil {
 		return fmt.Errorf("create secret %s failed: %v", kubeConfigSecret.Name, err)
 	}
+	return nil
+}
 
+// createRBACInMemberCluster create the rbac of karmada-agent in member cluster
+func (o pullModeOption) createRBACInMemberCluster() error {
 	clusterRole := &rbacv1.ClusterRole{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: KarmadaAgentName,
@@ -119,7 +197,7 @@ func (o pullModeOption) createSecretAndRBACInMemberCluster() error {
 	}
 
 	// create service account for karmada-agent
-	_, err = karmadautil.EnsureServiceAccountExist(o.memberClusterClient, sa, false)
+	_, err := karmadautil.EnsureServiceAccountExist(o.memberClusterClient, sa, false)
 	if err != nil {
 		return err
 	}
@@ -150,8 +228,95 @@ func (o pullModeOption) createSecretAndRBACInMemberCluster() error {
 	return nil
 }
 
+// agentImage returns the karmada-agent image reference. version is only appended as tag to images that carry
+// neither a tag nor a digest already.
+func agentImage(image, version string) (string, error) {
+	ref, err := reference.Parse(image)
+	if err != nil {
+		return "", fmt.Errorf("invalid karmada-agent image %q: %w", image, err)
+	}
+	_, tagged := ref.(reference.Tagged)
+	_, digested := ref.(reference.Digested)
+	if version == "" || tagged || digested {
+		return image, nil
+	}
+	named, ok := ref.(reference.Named)
+	if !ok {
+		return "", fmt.Errorf("invalid karmada-agent image %q: missing repository name", image)
+	}
+	withTag, err := reference.WithTag(named, version)
+	if err != nil {
+		return "", fmt.Errorf("invalid karmada-agent version %q: %w", version, err)
+	}
+	return withTag.String(), nil
+}
+
 // makeKarmadaAgentDeployment generate karmada-agent Deployment
-func (o pullModeOption) makeKarmadaAgentDeployment() *appsv1.Deployment {
+func (o pullModeOption) makeKarmadaAgentDeployment() (*appsv1.Deployment, error) {
+	requests, err := parseResourceList(o.agent.Resources.Requests)
+	if err != nil {
+		return nil, err
+	}
+	limits, err := parseResourceList(o.agent.Resources.Limits)
+	if err != nil {
+		return nil, err
+	}
+
+	image, err := agentImage(o.agent.Image, o.agent.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	command := []string{
+		"/bin/karmada-agent",
+		"--karmada-kubeconfig=/etc/kubeconfig/karmada-kubeconfig",
+		fmt.Sprintf("--cluster-name=%s", o.memberClusterName),
+		fmt.Sprintf("--cluster-api-endpoint=%s", o.memberClusterEndpoint),
+		fmt.Sprintf("--leader-elect-resource-namespace=%s", o.memberClusterNamespace),
+	}
+	if o.clusterProvider != "" {
+		command = append(command, fmt.Sprintf("--cluster-provider=%s", o.clusterProvider))
+	}
+	if o.clusterRegion != "" {
+		command = append(command, fmt.Sprintf("--cluster-region=%s", o.clusterRegion))
+	}
+	if len(o.clusterZones) > 0 {
+		command = append(command, fmt.Sprintf("--cluster-zones=%s", strings.Join(o.clusterZones, ",")))
+	}
+	if o.agent.ProxyServerAddress != "" {
+		command = append(command, fmt.Sprintf("--proxy-server-address=%s", o.agent.ProxyServerAddress))
+	}
+	if len(o.agent.FeatureGates) > 0 {
+		gates := make([]string, 0, len(o.agent.FeatureGates))
+		for gate, enabled := range o.agent.FeatureGates {
+			gates = append(gates, fmt.Sprintf("%s=%t", gate, enabled))
+		}
+		sort.Strings(gates)
+		command = append(command, fmt.Sprintf("--feature-gates=%s", strings.Join(gates, ",")))
+	}
+	command = append(command,
+		"--cluster-status-update-frequency=10s",
+		"--bind-address=0.0.0.0",
+		"--secure-port=10357",
+		fmt.Sprintf("--v=%d", ptr.Deref(o.agent.LogLevel, 0)),
+	)
+
+	var env []corev1.EnvVar
+	for _, proxy := range []struct{ name, value string }{
+		{"HTTP_PROXY", o.agent.HTTPProxy},
+		{"HTTPS_PROXY", o.agent.HTTPSProxy},
+		{"NO_PROXY", o.agent.NoProxy},
+	} {
+		if proxy.value != "" {
+			env = append(env, corev1.EnvVar{Name: proxy.name, Value: proxy.value})
+		}
+	}
+
+	var imagePullSecrets []corev1.LocalObjectReference
+	for _, secret := range o.agent.ImagePullSecrets {
+		imagePullSecrets = append(imagePullSecrets, corev1.LocalObjectReference{Name: secret})
+	}
+
 	karmadaAgent := &appsv1.Deployment{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: "apps/v1",
@@ -164,34 +329,18 @@ func (o pullModeOption) makeKarmadaAgentDeployment() *appsv1.Deployment {
 		},
 	}
 
-	//controllers := []string{"*"}
 	podSpec := corev1.PodSpec{
-		ImagePullSecrets: []corev1.LocalObjectReference{
-			{
-				Name: "fronted-cn-beijing",
-			},
-		},
+		ImagePullSecrets:   imagePullSecrets,
 		ServiceAccountName: KarmadaAgentServiceAccountName,
 		Containers: []corev1.Container{
 			{
-				Name:  KarmadaAgentName,
-				Image: KarmadaAgentImage,
-				Command: []string{
-					"/bin/karmada-agent",
-					"--karmada-kubeconfig=/etc/kubeconfig/karmada-kubeconfig",
-					fmt.Sprintf("--cluster-name=%s", o.memberClusterName),
-					fmt.Sprintf("--cluster-api-endpoint=%s", o.memberClusterEndpoint),
-					//fmt.Sprintf("--cluster-provider=%s", clusterProvider),
-					//fmt.Sprintf("--cluster-region=%s", clusterRegion),
-					//fmt.Sprintf("--cluster-zones=%s", strings.Join(clusterZones, ",")),
-					//fmt.Sprintf("--controllers=%s", strings.Join(controllers, ",")),
-					//fmt.Sprintf("--proxy-server-address=%s", proxyServerAddress),
-					//fmt.Sprintf("--leader-elect-resource-namespace=%s", o.memberClusterNamespace),
-					"--feature-gates=CustomizedClusterResourceModeling=true,MultiClusterService=true",
-					"--cluster-status-update-frequency=10s",
-					"--bind-address=0.0.0.0",
-					"--secure-port=10357",
-					"--v=4",
+				Name:    KarmadaAgentName,
+				Image:   image,
+				Command: command,
+				Env:     env,
+				Resources: corev1.ResourceRequirements{
+					Requests: requests,
+					Limits:   limits,
 				},
 				VolumeMounts: []corev1.VolumeMount{
 					{
@@ -228,96 +377,382 @@ func (o pullModeOption) makeKarmadaAgentDeployment() *appsv1.Deployment {
 		Spec: podSpec,
 	}
 	// DeploymentSpec
+	replicas := o.agent.Replicas
 	karmadaAgent.Spec = appsv1.DeploymentSpec{
-		Replicas: &karmadaAgentReplicas,
+		Replicas: &replicas,
 		Template: podTemplateSpec,
 		Selector: &metav1.LabelSelector{
 			MatchLabels: karmadaAgentLabels,
 		},
 	}
 
-	return karmadaAgent
+	return karmadaAgent, nil
 }
 
-func accessClusterInPullMode(opts *pullModeOption) error {
-	_, exist, err := karmadautil.GetClusterWithKarmadaClient(opts.karmadaClient, opts.memberClusterName)
-	if err != nil {
+// deleteKarmadaAgentRBAC removes the rbac of karmada-agent deployed in namespace from member cluster
+func deleteKarmadaAgentRBAC(memberClusterClient kubeclient.Interface, namespace string) error {
+	if err := karmadautil.DeleteClusterRoleBinding(memberClusterClient, KarmadaAgentName); err != nil {
 		return err
 	}
-	if exist {
-		return fmt.Errorf("failed to register as cluster with name %s already exists", opts.memberClusterName)
+	if err := karmadautil.DeleteClusterRole(memberClusterClient, KarmadaAgentName); err != nil {
+		return err
 	}
-	// It's necessary to set the label of namespace to make sure that the namespace is created by Karmada.
-	labels := map[string]string{
-		karmadautil.ManagedByKarmadaLabel: karmadautil.ManagedByKarmadaLabelValue,
+	return karmadautil.DeleteServiceAccount(memberClusterClient, namespace, KarmadaAgentServiceAccountName)
+}
+
+// karmadaAgentRBAC tells which of the rbac objects of karmada-agent exist in a member cluster.
+type karmadaAgentRBAC struct {
+	clusterRole        bool
+	clusterRoleBinding bool
+	serviceAccount     bool
+}
+
+// existingKarmadaAgentRBAC looks up the rbac objects of karmada-agent deployed in namespace.
+func existingKarmadaAgentRBAC(memberClusterClient kubeclient.Interface, namespace string) (karmadaAgentRBAC, error) {
+	var existing karmadaAgentRBAC
+	var err error
+	if existing.clusterRole, err = karmadautil.IsClusterRoleExist(memberClusterClient, KarmadaAgentName); err != nil {
+		return existing, err
 	}
-	// ensure namespace where the karmada-agent resources be deployed exists in the member cluster
-	if _, err = karmadautil.EnsureNamespaceExistWithLabels(opts.memberClusterClient, opts.memberClusterNamespace, false, labels); err != nil {
-		return err
+	if existing.clusterRoleBinding, err = karmadautil.IsClusterRoleBindingExist(memberClusterClient, KarmadaAgentName); err != nil {
+		return existing, err
 	}
-	if err = opts.createSecretAndRBACInMemberCluster(); err != nil {
-		return err
+	existing.serviceAccount, err = karmadautil.IsServiceAccountExist(memberClusterClient, namespace, KarmadaAgentServiceAccountName)
+	return existing, err
+}
+
+// joinStepsInPullMode returns the steps deploying karmada-agent to the member cluster and waiting for it to register
+// the cluster, each with the rollback of what it created.
+func joinStepsInPullMode(opts *pullModeOption) []operationStep {
+	var karmadaAgentDeployment *appsv1.Deployment
+	var previousSecret *corev1.Secret
+	var rbacExisted karmadaAgentRBAC
+	namespaceCreated, deploymentCreated := false, false
+	return []operationStep{
+		{
+			name: "Validate",
+			run: func(_ context.Context) error {
+				// build the deployment first so invalid agent options fail before anything is created in the member cluster
+				var err error
+				if karmadaAgentDeployment, err = opts.makeKarmadaAgentDeployment(); err != nil {
+					return err
+				}
+				_, exist, err := karmadautil.GetClusterWithKarmadaClient(opts.karmadaClient, opts.memberClusterName)
+				if err != nil {
+					return err
+				}
+				if exist {
+					return fmt.Errorf("failed to register as cluster with name %s already exists", opts.memberClusterName)
+				}
+				return nil
+			},
+		},
+		{
+			name: "Namespace",
+			run: func(ctx context.Context) error {
+				_, err := opts.memberClusterClient.CoreV1().Namespaces().Get(ctx, opts.memberClusterNamespace, metav1.GetOptions{})
+				if err != nil && !apierrors.IsNotFound(err) {
+					return err
+				}
+				namespaceCreated = apierrors.IsNotFound(err)
+				// It's necessary to set the label of namespace to make sure that the namespace is created by Karmada.
+				labels := map[string]string{
+					karmadautil.ManagedByKarmadaLabel: karmadautil.ManagedByKarmadaLabelValue,
+				}
+				// ensure namespace where the karmada-agent resources be deployed exists in the member cluster
+				_, err = karmadautil.EnsureNamespaceExistWithLabels(opts.memberClusterClient, opts.memberClusterNamespace, false, labels)
+				return err
+			},
+			rollback: func(_ context.Context) error {
+				if !namespaceCreated {
+					return nil
+				}
+				return karmadautil.DeleteNamespace(opts.memberClusterClient, opts.memberClusterNamespace)
+			},
+		},
+		{
+			name: "Secret",
+			run: func(ctx context.Context) error {
+				secret, err := opts.memberClusterClient.CoreV1().Secrets(opts.memberClusterNamespace).Get(ctx, KarmadaKubeconfigName, metav1.GetOptions{})
+				if err != nil && !apierrors.IsNotFound(err) {
+					return err
+				}
+				if err == nil {
+					previousSecret = secret
+				}
+				return opts.createSecretInMemberCluster()
+			},
+			rollback: func(ctx context.Context) error {
+				// a secret that existed before the join is restored rather than deleted
+				if previousSecret == nil {
+					return karmadautil.DeleteSecret(opts.memberClusterClient, opts.memberClusterNamespace, KarmadaKubeconfigName)
+				}
+				secrets := opts.memberClusterClient.CoreV1().Secrets(opts.memberClusterNamespace)
+				current, err := secrets.Get(ctx, KarmadaKubeconfigName, metav1.GetOptions{})
+				if apierrors.IsNotFound(err) {
+					previousSecret.ResourceVersion = ""
+					_, err = secrets.Create(ctx, previousSecret, metav1.CreateOptions{})
+					return err
+				}
+				if err != nil {
+					return err
+				}
+				current.Type = previousSecret.Type
+				current.Data = previousSecret.Data
+				current.StringData = nil
+				_, err = secrets.Update(ctx, current, metav1.UpdateOptions{})
+				return err
+			},
+		},
+		{
+			name: "RBAC",
+			run: func(_ context.Context) error {
+				var err error
+				if rbacExisted, err = existingKarmadaAgentRBAC(opts.memberClusterClient, opts.memberClusterNamespace); err != nil {
+					return err
+				}
+				return opts.createRBACInMemberCluster()
+			},
+			rollback: func(_ context.Context) error {
+				// rbac objects that existed before the join belong to an agent already running in the member cluster
+				if !rbacExisted.clusterRoleBinding {
+					if err := karmadautil.DeleteClusterRoleBinding(opts.memberClusterClient, KarmadaAgentName); err != nil {
+						return err
+					}
+				}
+				if !rbacExisted.clusterRole {
+					if err := karmadautil.DeleteClusterRole(opts.memberClusterClient, KarmadaAgentName); err != nil {
+						return err
+					}
+				}
+				if !rbacExisted.serviceAccount {
+					return karmadautil.DeleteServiceAccount(opts.memberClusterClient, opts.memberClusterNamespace, KarmadaAgentServiceAccountName)
+				}
+				return nil
+			},
+		},
+		{
+			name: "AgentDeployment",
+			run: func(ctx context.Context) error {
+				if _, err := opts.memberClusterClient.AppsV1().Deployments(opts.memberClusterNamespace).Create(ctx, karmadaAgentDeployment, metav1.CreateOptions{}); err != nil {
+					return err
+				}
+				deploymentCreated = true
+				return cmdutil.WaitForDeploymentRollout(opts.memberClusterClient, karmadaAgentDeployment, timeout)
+			},
+			rollback: func(ctx context.Context) error {
+				// a deployment that already existed, e.g. a running karmada-agent, is left alone
+				if !deploymentCreated {
+					return nil
+				}
+				err := opts.memberClusterClient.AppsV1().Deployments(opts.memberClusterNamespace).Delete(ctx, KarmadaAgentName, metav1.DeleteOptions{})
+				if apierrors.IsNotFound(err) {
+					return nil
+				}
+				return err
+			},
+		},
+		{
+			// a ready deployment does not mean the agent could register, the Cluster object tells
+			name: "ClusterReady",
+			run: func(ctx context.Context) error {
+				return waitForClusterReady(ctx, opts.karmadaClient, opts.memberClusterName)
+			},
+			rollback: func(ctx context.Context) error {
+				return deleteClusterObject(ctx, opts.karmadaClient, opts.memberClusterName)
+			},
+		},
 	}
-	karmadaAgentDeployment := opts.makeKarmadaAgentDeployment()
-	if _, err = opts.memberClusterClient.AppsV1().Deployments(opts.memberClusterNamespace).Create(context.TODO(), karmadaAgentDeployment, metav1.CreateOptions{}); err != nil {
-		return err
+}
+
+// waitForClusterReady waits until the Cluster object exists and reports Ready.
+func waitForClusterReady(ctx context.Context, karmadaClient karmadaclientset.Interface, clusterName string) error {
+	var lastErr error
+	err := wait.PollUntilContextTimeout(ctx, time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+		cluster, err := karmadaClient.ClusterV1alpha1().Clusters().Get(ctx, clusterName, metav1.GetOptions{})
+		if err != nil {
+			lastErr = err
+			return false, nil
+		}
+		return karmadautil.IsClusterReady(&cluster.Status), nil
+	})
+	if err != nil && lastErr != nil {
+		return fmt.Errorf("cluster %s is not ready: %w", clusterName, lastErr)
 	}
-	// TODO: deployment ready cannot exactly express that cluster is ready
-	// It should also check cluster resource on karmada control-plane
-	// maybe karmadactl should optimized it
-	if err = cmdutil.WaitForDeploymentRollout(opts.memberClusterClient, karmadaAgentDeployment, timeout); err != nil {
-		return err
+	if err != nil {
+		return fmt.Errorf("cluster %s is not ready: %w", clusterName, err)
 	}
 	return nil
 }
 
+// deleteClusterObject deletes the Cluster object and waits until it is gone.
+func deleteClusterObject(ctx context.Context, karmadaClient karmadaclientset.Interface, clusterName string) error {
+	err := karmadaClient.ClusterV1alpha1().Clusters().Delete(ctx, clusterName, metav1.DeleteOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return waitForClusterDeleted(ctx, karmadaClient, clusterName)
+}
+
+// waitForClusterDeleted waits until the Cluster object is gone.
+func waitForClusterDeleted(ctx context.Context, karmadaClient karmadaclientset.Interface, clusterName string) error {
+	return wait.PollUntilContextTimeout(ctx, time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+		_, err := karmadaClient.ClusterV1alpha1().Clusters().Get(ctx, clusterName, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		klog.Infof("Waiting for the cluster object %s to be deleted", clusterName)
+		return false, nil
+	})
+}
+
 type pushModeOption struct {
 	karmadaClient           karmadaclientset.Interface
 	clusterName             string
+	clusterProvider         string
+	clusterRegion           string
+	clusterZones            []string
 	karmadaRestConfig       *rest.Config
 	memberClusterRestConfig *rest.Config
 }
 
-func accessClusterInPushMode(opts *pushModeOption) error {
+// joinStepsInPushMode returns the steps creating credentials in the member cluster and registering it in the
+// control plane, each with the rollback of what it created.
+func joinStepsInPushMode(opts *pushModeOption) []operationStep {
 	registerOption := karmadautil.ClusterRegisterOption{
 		ClusterNamespace:   ClusterNamespace,
 		ClusterName:        opts.clusterName,
 		ReportSecrets:      []string{karmadautil.KubeCredentials, karmadautil.KubeImpersonator},
 		ControlPlaneConfig: opts.karmadaRestConfig,
 		ClusterConfig:      opts.memberClusterRestConfig,
+		ClusterProvider:    opts.clusterProvider,
+		ClusterRegion:      opts.clusterRegion,
+		ClusterZones:       opts.clusterZones,
+	}
+	var controlPlaneKubeClient, memberClusterKubeClient kubeclient.Interface
+	namespaceCreated := false
+	return []operationStep{
+		{
+			name: "Validate",
+			run: func(_ context.Context) error {
+				var err error
+				if controlPlaneKubeClient, err = kubeclient.NewForConfig(opts.karmadaRestConfig); err != nil {
+					return err
+				}
+				if memberClusterKubeClient, err = kubeclient.NewForConfig(opts.memberClusterRestConfig); err != nil {
+					return err
+				}
+				clusterID, err := karmadautil.ObtainClusterID(memberClusterKubeClient)
+				if err != nil {
+					klog.ErrorS(err, "ObtainClusterID failed")
+					return err
+				}
+				registerOption.ClusterID = clusterID
+				return registerOption.Validate(opts.karmadaClient, true)
+			},
+		},
+		{
+			// the service accounts and rbac the control plane uses to access the member cluster
+			name: "Credentials",
+			run: func(ctx context.Context) error {
+				_, err := memberClusterKubeClient.CoreV1().Namespaces().Get(ctx, ClusterNamespace, metav1.GetOptions{})
+				if err != nil && !apierrors.IsNotFound(err) {
+					return err
+				}
+				namespaceCreated = apierrors.IsNotFound(err)
+				clusterSecret, impersonatorSecret, err := karmadautil.ObtainCredentialsFromMemberCluster(memberClusterKubeClient, registerOption)
+				if err != nil {
+					klog.ErrorS(err, "ObtainCredentialsFromMemberCluster failed")
+					return err
+				}
+				if clusterSecret != nil {
+					registerOption.Secret = *clusterSecret
+				}
+				if impersonatorSecret != nil {
+					registerOption.ImpersonatorSecret = *impersonatorSecret
+				}
+				return nil
+			},
+			rollback: func(_ context.Context) error {
+				serviceAccountName := names.GenerateServiceAccountName(opts.clusterName)
+				clusterRoleName := names.GenerateRoleName(serviceAccountName)
+				if err := karmadautil.DeleteClusterRoleBinding(memberClusterKubeClient, clusterRoleName); err != nil {
+					return err
+				}
+				if err := karmadautil.DeleteClusterRole(memberClusterKubeClient, clusterRoleName); err != nil {
+					return err
+				}
+				if namespaceCreated {
+					return karmadautil.DeleteNamespace(memberClusterKubeClient, ClusterNamespace)
+				}
+				for _, name := range []string{serviceAccountName, names.GenerateServiceAccountName("impersonator")} {
+					if err := karmadautil.DeleteServiceAccount(memberClusterKubeClient, ClusterNamespace, name); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+		},
+		{
+			name: "ClusterObject",
+			run: func(_ context.Context) error {
+				err := karmadautil.RegisterClusterInControllerPlane(registerOption, controlPlaneKubeClient, generateClusterInControllerPlane)
+				if err != nil {
+					return fmt.Errorf("failed to register with karmada control plane: %w", err)
+				}
+				return nil
+			},
+			rollback: func(ctx context.Context) error {
+				if err := deleteClusterObject(ctx, opts.karmadaClient, opts.clusterName); err != nil {
+					return err
+				}
+				if err := karmadautil.DeleteSecret(controlPlaneKubeClient, ClusterNamespace, opts.clusterName); err != nil {
+					return err
+				}
+				return karmadautil.DeleteSecret(controlPlaneKubeClient, ClusterNamespace, names.GenerateImpersonationSecretName(opts.clusterName))
+			},
+		},
+		{
+			name: "ClusterReady",
+			run: func(ctx context.Context) error {
+				if err := waitForClusterReady(ctx, opts.karmadaClient, opts.clusterName); err != nil {
+					return err
+				}
+				klog.Infof("cluster(%s) is joined successfully\n", opts.clusterName)
+				return nil
+			},
+		},
 	}
+}
 
-	controlPlaneKubeClient := kubeclient.NewForConfigOrDie(opts.karmadaRestConfig)
-	memberClusterKubeClient := kubeclient.NewForConfigOrDie(opts.memberClusterRestConfig)
-	clusterID, err := karmadautil.ObtainClusterID(memberClusterKubeClient)
-	if err != nil {
-		klog.ErrorS(err, "ObtainClusterID failed")
-		return err
-	}
-	registerOption.ClusterID = clusterID
-
-	if err = registerOption.Validate(opts.karmadaClient, true); err != nil {
-		return err
-	}
-
-	clusterSecret, impersonatorSecret, err := karmadautil.ObtainCredentialsFromMemberCluster(memberClusterKubeClient, registerOption)
-	if err != nil {
-		klog.ErrorS(err, "ObtainCredentialsFromMemberCluster failed")
-		return err
-	}
-	if clusterSecret != nil {
-		registerOption.Secret = *clusterSecret
-	}
-	if impersonatorSecret != nil {
-		registerOption.ImpersonatorSecret = *impersonatorSecret
-	}
-
-	err = karmadautil.RegisterClusterInControllerPlane(registerOption, controlPlaneKubeClient, generateClusterInControllerPlane)
-	if err != nil {
-		return fmt.Errorf("failed to register with karmada control plane: %w", err)
+// unjoinSteps returns the steps removing the Cluster object from the control plane. Deleting a cluster cannot be
+// undone, so they have no rollback.
+func unjoinSteps(karmadaClient karmadaclientset.Interface, clusterName string) []operationStep {
+	return []operationStep{
+		{
+			name: "DeleteCluster",
+			run: func(ctx context.Context) error {
+				err := karmadaClient.ClusterV1alpha1().Clusters().Delete(ctx, clusterName, metav1.DeleteOptions{})
+				if apierrors.IsNotFound(err) {
+					return fmt.Errorf("no cluster object %s found in karmada control Plane", clusterName)
+				}
+				return err
+			},
+		},
+		{
+			// karmada cleans up the works and execution namespace of the cluster before the object goes away
+			name: "WaitForDeletion",
+			run: func(ctx context.Context) error {
+				return waitForClusterDeleted(ctx, karmadaClient, clusterName)
+			},
+		},
 	}
-	klog.Infof("cluster(%s) is joined successfully\n", opts.clusterName)
-	return nil
 }
 
 func generateClusterInControllerPlane(opts karmadautil.ClusterRegisterOption) (*clusterv1alpha1.Cluster, error) {
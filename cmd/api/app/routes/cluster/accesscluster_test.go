@@ -0,0 +1,201 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"testing"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	karmadafake "github.com/karmada-io/karmada/pkg/generated/clientset/versioned/fake"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"k8s.io/utils/ptr"
+
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+)
+
+func TestMakeKarmadaAgentDeployment(t *testing.T) {
+	replicas := int32(1)
+	opts := pullModeOption{
+		memberClusterNamespace: "karmada-system",
+		memberClusterName:      "member1",
+		memberClusterEndpoint:  "https://10.0.0.1:6443",
+		clusterRegion:          "cn-east",
+		clusterZones:           []string{"a", "b"},
+		agent: karmadaAgentConfigFromRequest(&v1.KarmadaAgentOptions{
+			Image:            "registry.local/karmada/karmada-agent",
+			Version:          "v1.18.1",
+			ImagePullSecrets: []string{"registry-local"},
+			Replicas:         &replicas,
+			ResourceRequests: map[string]string{"cpu": "100m"},
+			FeatureGates:     map[string]bool{"MultiClusterService": false, "Failover": true},
+			HTTPSProxy:       "http://proxy.local:3128",
+		}),
+	}
+
+	deployment, err := opts.makeKarmadaAgentDeployment()
+	if err != nil {
+		t.Fatalf("makeKarmadaAgentDeployment() error = %v", err)
+	}
+	if *deployment.Spec.Replicas != 1 {
+		t.Errorf("replicas = %d, want 1", *deployment.Spec.Replicas)
+	}
+	podSpec := deployment.Spec.Template.Spec
+	if want := []corev1.LocalObjectReference{{Name: "registry-local"}}; !reflect.DeepEqual(podSpec.ImagePullSecrets, want) {
+		t.Errorf("imagePullSecrets = %v, want %v", podSpec.ImagePullSecrets, want)
+	}
+	container := podSpec.Containers[0]
+	if container.Image != "registry.local/karmada/karmada-agent:v1.18.1" {
+		t.Errorf("image = %s", container.Image)
+	}
+	if cpu := container.Resources.Requests[corev1.ResourceCPU]; cpu.String() != "100m" {
+		t.Errorf("cpu request = %s, want 100m", cpu.String())
+	}
+	if want := []corev1.EnvVar{{Name: "HTTPS_PROXY", Value: "http://proxy.local:3128"}}; !reflect.DeepEqual(container.Env, want) {
+		t.Errorf("env = %v, want %v", container.Env, want)
+	}
+	flags := map[string]bool{}
+	for _, arg := range container.Command {
+		flags[arg] = true
+	}
+	for _, want := range []string{
+		"--cluster-region=cn-east",
+		"--cluster-zones=a,b",
+		"--feature-gates=Failover=true,MultiClusterService=false",
+		"--v=4",
+	} {
+		if !flags[want] {
+			t.Errorf("command %v is missing %s", container.Command, want)
+		}
+	}
+
+	opts.agent.LogLevel = ptr.To(0)
+	if deployment, err = opts.makeKarmadaAgentDeployment(); err != nil {
+		t.Fatalf("makeKarmadaAgentDeployment() error = %v", err)
+	}
+	if command := deployment.Spec.Template.Spec.Containers[0].Command; !slices.Contains(command, "--v=0") {
+		t.Errorf("command %v is missing --v=0 for an explicit log level 0", command)
+	}
+
+	opts.agent.Resources.Limits = map[string]string{"memory": "lots"}
+	if _, err = opts.makeKarmadaAgentDeployment(); err == nil {
+		t.Errorf("makeKarmadaAgentDeployment() accepted an invalid memory limit")
+	}
+}
+
+func TestAgentImage(t *testing.T) {
+	tests := []struct {
+		image   string
+		version string
+		want    string
+		wantErr bool
+	}{
+		{image: "registry.local:5000/karmada/karmada-agent", version: "v1.18.1", want: "registry.local:5000/karmada/karmada-agent:v1.18.1"},
+		{image: "karmada/karmada-agent:latest", version: "v1.18.1", want: "karmada/karmada-agent:latest"},
+		{
+			image:   "karmada/karmada-agent@sha256:0000000000000000000000000000000000000000000000000000000000000000",
+			version: "v1.18.1",
+			want:    "karmada/karmada-agent@sha256:0000000000000000000000000000000000000000000000000000000000000000",
+		},
+		{image: "karmada/karmada-agent", want: "karmada/karmada-agent"},
+		{image: "karmada/Karmada-Agent", version: "v1.18.1", wantErr: true},
+		{image: "karmada/karmada-agent", version: "v1.18.1:latest", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := agentImage(tt.image, tt.version)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("agentImage(%q, %q) error = %v, wantErr %v", tt.image, tt.version, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("agentImage(%q, %q) = %q, want %q", tt.image, tt.version, got, tt.want)
+		}
+	}
+}
+
+func TestJoinStepsInPullModeKeepExistingAgent(t *testing.T) {
+	memberClusterClient := k8sfake.NewSimpleClientset(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "karmada-system"}},
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: KarmadaAgentName, Namespace: "karmada-system"}},
+		&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: KarmadaAgentName}},
+		&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: KarmadaAgentName}},
+		&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: KarmadaAgentServiceAccountName, Namespace: "karmada-system"}},
+	)
+	opts := &pullModeOption{
+		karmadaClient:          karmadafake.NewSimpleClientset(),
+		karmadaAgentCfg:        clientcmdapi.NewConfig(),
+		memberClusterNamespace: "karmada-system",
+		memberClusterClient:    memberClusterClient,
+		memberClusterName:      "member1",
+		memberClusterEndpoint:  "https://10.0.0.1:6443",
+		agent:                  karmadaAgentConfigFromRequest(&v1.KarmadaAgentOptions{Image: "karmada/karmada-agent", Version: "v1.18.1"}),
+	}
+	store := &operationStore{
+		operations: map[string]*v1.ClusterOperation{},
+		watchers:   map[string][]chan struct{}{},
+	}
+
+	op, err := store.start("alice", v1.ClusterOperationJoin, "member1", clusterv1alpha1.Pull, joinStepsInPullMode(opts))
+	if err != nil {
+		t.Fatalf("start() error = %v", err)
+	}
+	op = waitForOperation(t, store, op.ID, "alice")
+	if op.Phase != v1.ClusterOperationFailed || op.Steps[4].Name != "AgentDeployment" || op.Steps[4].Phase != v1.ClusterOperationFailed {
+		t.Fatalf("operation phase = %s, error = %q, want the AgentDeployment step to fail", op.Phase, op.Error)
+	}
+
+	ctx := context.TODO()
+	for name, get := range map[string]func() error{
+		"deployment": func() error {
+			_, err := memberClusterClient.AppsV1().Deployments("karmada-system").Get(ctx, KarmadaAgentName, metav1.GetOptions{})
+			return err
+		},
+		"clusterrole": func() error {
+			_, err := memberClusterClient.RbacV1().ClusterRoles().Get(ctx, KarmadaAgentName, metav1.GetOptions{})
+			return err
+		},
+		"clusterrolebinding": func() error {
+			_, err := memberClusterClient.RbacV1().ClusterRoleBindings().Get(ctx, KarmadaAgentName, metav1.GetOptions{})
+			return err
+		},
+		"serviceaccount": func() error {
+			_, err := memberClusterClient.CoreV1().ServiceAccounts("karmada-system").Get(ctx, KarmadaAgentServiceAccountName, metav1.GetOptions{})
+			return err
+		},
+		"namespace": func() error {
+			_, err := memberClusterClient.CoreV1().Namespaces().Get(ctx, "karmada-system", metav1.GetOptions{})
+			return err
+		},
+	} {
+		if err := get(); err != nil {
+			t.Errorf("pre-existing %s was removed by the rollback: %v", name, err)
+		}
+	}
+	// the secret did not exist before the join, so the rollback removes it
+	_, err = memberClusterClient.CoreV1().Secrets("karmada-system").Get(ctx, KarmadaKubeconfigName, metav1.GetOptions{})
+	if !apierrors.IsNotFound(err) {
+		t.Errorf("secret %s created by the join was not rolled back: %v", KarmadaKubeconfigName, err)
+	}
+}
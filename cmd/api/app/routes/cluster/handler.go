@@ -18,15 +18,17 @@ package cluster
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	authorizationv1 "k8s.io/api/authorization/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/klog/v2"
 
 	"github.com/karmada-io/dashboard/cmd/api/app/router"
@@ -36,6 +38,9 @@ import (
 	"github.com/karmada-io/dashboard/pkg/resource/cluster"
 )
 
+// sseKeepaliveInterval is how often an idle cluster operation stream is pinged.
+const sseKeepaliveInterval = 20 * time.Second
+
 func handleGetClusterList(c *gin.Context) {
 	karmadaClient, err := router.GetKarmadaClientFromContext(c)
 	if err != nil {
@@ -68,6 +73,16 @@ func handleGetClusterDetail(c *gin.Context) {
 	common.Success(c, result)
 }
 
+// handleProbeCluster probes the member apiserver through the Karmada cluster proxy with the caller's credentials.
+func handleProbeCluster(c *gin.Context) {
+	memberClient, err := client.ClientForMemberClusterFromRequest(c.Request, c.Param("name"))
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, cluster.ProbeClusterHealth(c.Request.Context(), memberClient))
+}
+
 func handlePostCluster(c *gin.Context) {
 	clusterRequest := new(v1.PostClusterRequest)
 	if err := c.ShouldBind(clusterRequest); err != nil {
@@ -82,7 +97,13 @@ func handlePostCluster(c *gin.Context) {
 		return
 	}
 	clusterRequest.MemberClusterEndpoint = memberClusterEndpoint
-	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	// the rollback of a failed join deletes the cluster object again
+	karmadaClient, err := authorizeOperation(c, clusterRequest.MemberClusterName, "create", "delete")
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	user, err := operationUser(c)
 	if err != nil {
 		common.Fail(c, err)
 		return
@@ -109,14 +130,17 @@ func handlePostCluster(c *gin.Context) {
 			memberClusterClient:    memberClusterClient,
 			memberClusterName:      clusterRequest.MemberClusterName,
 			memberClusterEndpoint:  clusterRequest.MemberClusterEndpoint,
+			clusterProvider:        clusterRequest.ClusterProvider,
+			clusterRegion:          clusterRequest.ClusterRegion,
+			clusterZones:           clusterRequest.ClusterZones,
+			agent:                  karmadaAgentConfigFromRequest(clusterRequest.KarmadaAgent),
 		}
-		if err = accessClusterInPullMode(opts); err != nil {
-			klog.ErrorS(err, "accessClusterInPullMode failed")
+		op, err := operations.start(user, v1.ClusterOperationJoin, opts.memberClusterName, v1alpha1.Pull, joinStepsInPullMode(opts))
+		if err != nil {
 			common.Fail(c, err)
-		} else {
-			klog.Infof("accessClusterInPullMode success")
-			common.Success(c, "ok")
+			return
 		}
+		common.Success(c, op)
 	case v1alpha1.Push:
 		memberClusterRestConfig, err := client.LoadRestConfigFromKubeConfig(clusterRequest.MemberClusterKubeConfig)
 		if err != nil {
@@ -133,16 +157,18 @@ func handlePostCluster(c *gin.Context) {
 		opts := &pushModeOption{
 			karmadaClient:           karmadaClient,
 			clusterName:             clusterRequest.MemberClusterName,
+			clusterProvider:         clusterRequest.ClusterProvider,
+			clusterRegion:           clusterRequest.ClusterRegion,
+			clusterZones:            clusterRequest.ClusterZones,
 			karmadaRestConfig:       restConfig,
 			memberClusterRestConfig: memberClusterRestConfig,
 		}
-		if err := accessClusterInPushMode(opts); err != nil {
-			klog.ErrorS(err, "accessClusterInPushMode failed")
+		op, err := operations.start(user, v1.ClusterOperationJoin, opts.clusterName, v1alpha1.Push, joinStepsInPushMode(opts))
+		if err != nil {
 			common.Fail(c, err)
 			return
 		}
-		klog.Infof("accessClusterInPushMode success")
-		common.Success(c, "ok")
+		common.Success(c, op)
 	default:
 		klog.Errorf("Unknown sync mode %s", clusterRequest.SyncMode)
 		common.Fail(c, fmt.Errorf("unknown sync mode %s", clusterRequest.SyncMode))
@@ -168,6 +194,7 @@ func handlePutCluster(c *gin.Context) {
 		common.Fail(c, err)
 		return
 	}
+	live := memberCluster.DeepCopy()
 
 	// assume that the frontend can fetch the whole labels and taints
 	labels := make(map[string]string)
@@ -190,17 +217,43 @@ func handlePutCluster(c *gin.Context) {
 		memberCluster.Spec.Taints = taints
 	}
 
-	_, err = karmadaClient.ClusterV1alpha1().Clusters().Update(context.TODO(), memberCluster, metav1.UpdateOptions{})
+	dryRun := common.ParseDryRunParameter(c)
+	updated, err := karmadaClient.ClusterV1alpha1().Clusters().Update(context.TODO(), memberCluster, metav1.UpdateOptions{DryRun: dryRun})
 	if err != nil {
 		klog.ErrorS(err, "Update cluster failed")
 		common.Fail(c, err)
 		return
 	}
+	if dryRun != nil {
+		common.DryRunSuccess(c, live, updated)
+		return
+	}
 	common.Success(c, "ok")
 }
 
+func handleBulkCluster(c *gin.Context) {
+	bulkRequest := new(v1.BulkClusterRequest)
+	if err := c.ShouldBind(bulkRequest); err != nil {
+		klog.ErrorS(err, "Could not read bulk cluster request")
+		common.Fail(c, err)
+		return
+	}
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	results, err := cluster.BulkUpdateClusters(c.Request.Context(), karmadaClient,
+		bulkRequest.ClusterNames, bulkRequest.LabelSelector, bulkRequest.Operations)
+	if err != nil {
+		klog.ErrorS(err, "BulkUpdateClusters failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, v1.BulkClusterResponse{Results: results})
+}
+
 func handleDeleteCluster(c *gin.Context) {
-	ctx := context.Context(c)
 	clusterRequest := new(v1.DeleteClusterRequest)
 	if err := c.ShouldBindUri(&clusterRequest); err != nil {
 		common.Fail(c, err)
@@ -212,38 +265,239 @@ func handleDeleteCluster(c *gin.Context) {
 		common.Fail(c, err)
 		return
 	}
-	waitDuration := time.Second * 60
-
-	err = karmadaClient.ClusterV1alpha1().Clusters().Delete(ctx, clusterName, metav1.DeleteOptions{})
+	user, err := operationUser(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	memberCluster, err := karmadaClient.ClusterV1alpha1().Clusters().Get(c, clusterName, metav1.GetOptions{})
 	if apierrors.IsNotFound(err) {
 		common.Fail(c, fmt.Errorf("no cluster object %s found in karmada control Plane", clusterName))
 		return
 	}
 	if err != nil {
-		klog.Errorf("Failed to delete cluster object. cluster name: %s, error: %v", clusterName, err)
 		common.Fail(c, err)
 		return
 	}
+	operationClient, err := authorizeOperation(c, clusterName, "delete")
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	op, err := operations.start(user, v1.ClusterOperationUnjoin, clusterName, memberCluster.Spec.SyncMode, unjoinSteps(operationClient, clusterName))
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, op)
+}
 
-	// make sure the given cluster object has been deleted
-	err = wait.PollUntilContextTimeout(ctx, 1*time.Second, waitDuration, true, func(ctx context.Context) (done bool, err error) {
-		_, err = karmadaClient.ClusterV1alpha1().Clusters().Get(ctx, clusterName, metav1.GetOptions{})
-		if apierrors.IsNotFound(err) {
-			return true, nil
-		}
+// handleGetClusterUnjoinPreview reports the workloads evicted and the credentials removed by unjoining a cluster.
+func handleGetClusterUnjoinPreview(c *gin.Context) {
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	result, err := cluster.GetUnjoinPreview(c.Request.Context(), karmadaClient, c.Param("name"))
+	if err != nil {
+		klog.ErrorS(err, "GetUnjoinPreview failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+// handleUnjoinCluster unjoins a cluster like handleDeleteCluster, then removes karmada-agent, the rbac and
+// credentials from the member cluster and the credential secrets from the control plane.
+func handleUnjoinCluster(c *gin.Context) {
+	unjoinRequest := new(v1.UnjoinClusterRequest)
+	if err := c.ShouldBind(unjoinRequest); err != nil {
+		klog.ErrorS(err, "Could not read unjoin cluster request")
+		common.Fail(c, err)
+		return
+	}
+	if unjoinRequest.MemberClusterKubeConfig == "" && !unjoinRequest.UseClusterCredentials {
+		common.Fail(c, fmt.Errorf("either memberClusterKubeconfig or useClusterCredentials is required"))
+		return
+	}
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	user, err := operationUser(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	clusterName := c.Param("name")
+	memberCluster, err := karmadaClient.ClusterV1alpha1().Clusters().Get(c, clusterName, metav1.GetOptions{})
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	operationClient, err := authorizeOperation(c, clusterName, "delete")
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	controlPlaneKubeClient := client.InClusterClientForKarmadaAPIServer()
+	if controlPlaneKubeClient == nil {
+		common.Fail(c, fmt.Errorf("kubernetes client for karmada apiserver is not initialized"))
+		return
+	}
+	opts := &unjoinOption{
+		karmadaClient:           operationClient,
+		controlPlaneKubeClient:  controlPlaneKubeClient,
+		clusterName:             clusterName,
+		memberClusterKubeConfig: unjoinRequest.MemberClusterKubeConfig,
+		useClusterCredentials:   unjoinRequest.UseClusterCredentials,
+		agentNamespace:          unjoinRequest.AgentNamespace,
+	}
+	op, err := operations.start(user, v1.ClusterOperationUnjoin, clusterName, memberCluster.Spec.SyncMode, fullUnjoinSteps(opts))
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, op)
+}
+
+// operationUser returns who the caller is authenticated as, cluster operations are only visible to the user
+// who started them.
+func operationUser(c *gin.Context) (string, error) {
+	kubeClient, err := router.GetKubeClientFromContext(c)
+	if err != nil {
+		return "", err
+	}
+	userInfo, err := client.ReviewSelf(c.Request.Context(), kubeClient)
+	if err != nil {
+		return "", err
+	}
+	return userInfo.Username, nil
+}
+
+// authorizeOperation checks with the caller's credentials that they may perform verbs on the cluster object and
+// returns the karmada client the operation runs with. Operations outlive the request and often the caller's token,
+// e.g. a short-lived OIDC ID token, so their steps run with the dashboard's own credentials instead.
+func authorizeOperation(c *gin.Context, clusterName string, verbs ...string) (karmadaclientset.Interface, error) {
+	kubeClient, err := router.GetKubeClientFromContext(c)
+	if err != nil {
+		return nil, err
+	}
+	for _, verb := range verbs {
+		review, err := kubeClient.AuthorizationV1().SelfSubjectAccessReviews().Create(c.Request.Context(), &authorizationv1.SelfSubjectAccessReview{
+			Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+				ResourceAttributes: &authorizationv1.ResourceAttributes{
+					Group:    v1alpha1.GroupName,
+					Resource: "clusters",
+					Name:     clusterName,
+					Verb:     verb,
+				},
+			},
+		}, metav1.CreateOptions{})
 		if err != nil {
-			klog.Errorf("Failed to get cluster %s. err: %v", clusterName, err)
-			return false, err
+			klog.ErrorS(err, "Could not review access to the cluster", "cluster", clusterName, "verb", verb)
+			return nil, err
 		}
-		klog.Infof("Waiting for the cluster object %s to be deleted", clusterName)
-		return false, nil
-	})
+		if !review.Status.Allowed {
+			return nil, apierrors.NewForbidden(v1alpha1.Resource("clusters"), clusterName,
+				fmt.Errorf("not allowed to %s clusters: %s", verb, review.Status.Reason))
+		}
+	}
+	karmadaClient := client.InClusterKarmadaClient()
+	if karmadaClient == nil {
+		return nil, fmt.Errorf("karmada client is not initialized")
+	}
+	return karmadaClient, nil
+}
+
+func handleGetClusterOperationList(c *gin.Context) {
+	user, err := operationUser(c)
 	if err != nil {
-		klog.Errorf("Failed to delete cluster object. cluster name: %s, error: %v", clusterName, err)
 		common.Fail(c, err)
 		return
 	}
-	common.Success(c, "ok")
+	common.Success(c, v1.ClusterOperationList{Operations: operations.list(user)})
+}
+
+func handleGetClusterOperation(c *gin.Context) {
+	user, err := operationUser(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	id := c.Param("operationId")
+	op, ok := operations.get(id, user)
+	if !ok {
+		common.Fail(c, fmt.Errorf("cluster operation %s not found", id))
+		return
+	}
+	common.Success(c, op)
+}
+
+// handleWatchClusterOperation streams the operation as Server-Sent Events, an "operation" event carries the whole
+// operation every time it changes and the stream ends once it has finished.
+func handleWatchClusterOperation(c *gin.Context) {
+	user, err := operationUser(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	id := c.Param("operationId")
+	if _, ok := operations.get(id, user); !ok {
+		common.Fail(c, fmt.Errorf("cluster operation %s not found", id))
+		return
+	}
+	changed, stop := operations.watch(id)
+	defer stop()
+
+	c.Writer.Header().Set("Content-Type", "text/event-stream")
+	c.Writer.Header().Set("Cache-Control", "no-cache")
+	c.Writer.Header().Set("Connection", "keep-alive")
+	c.Writer.Header().Set("X-Accel-Buffering", "no")
+	c.Writer.Flush()
+
+	if !sendOperationEvent(c, id, user) {
+		return
+	}
+	// Comment lines keep idle streams from being cut by proxies while a step is running.
+	keepalive := time.NewTicker(sseKeepaliveInterval)
+	defer keepalive.Stop()
+	for {
+		select {
+		case <-changed:
+			if !sendOperationEvent(c, id, user) {
+				return
+			}
+		case <-keepalive.C:
+			if _, err := fmt.Fprint(c.Writer, ": ping\n\n"); err != nil {
+				return
+			}
+			c.Writer.Flush()
+		case <-c.Request.Context().Done():
+			return
+		}
+	}
+}
+
+// sendOperationEvent writes the current state of an operation as an SSE event.
+// It reports whether the operation is still running and the stream should go on.
+func sendOperationEvent(c *gin.Context, id, user string) bool {
+	op, ok := operations.get(id, user)
+	if !ok {
+		return false
+	}
+	data, err := json.Marshal(op)
+	if err != nil {
+		klog.ErrorS(err, "Failed to marshal cluster operation", "operation", id)
+		return false
+	}
+	if _, err = fmt.Fprintf(c.Writer, "event: operation\ndata: %s\n\n", data); err != nil {
+		return false
+	}
+	c.Writer.Flush()
+	return op.Phase == v1.ClusterOperationRunning
 }
 
 func parseEndpointFromKubeconfig(kubeconfigContents string) (string, error) {
@@ -258,7 +512,14 @@ func init() {
 	r := router.V1()
 	r.GET("/cluster", handleGetClusterList)
 	r.GET("/cluster/:name", handleGetClusterDetail)
+	r.GET("/cluster/:name/probe", handleProbeCluster)
 	r.POST("/cluster", handlePostCluster)
 	r.PUT("/cluster/:name", handlePutCluster)
+	r.POST("/cluster/bulk", handleBulkCluster)
 	r.DELETE("/cluster/:name", handleDeleteCluster)
+	r.GET("/cluster/:name/unjoin/preview", handleGetClusterUnjoinPreview)
+	r.POST("/cluster/:name/unjoin", handleUnjoinCluster)
+	r.GET("/clusteroperation", handleGetClusterOperationList)
+	r.GET("/clusteroperation/:operationId", handleGetClusterOperation)
+	r.GET("/clusteroperation/:operationId/watch", handleWatchClusterOperation)
 }
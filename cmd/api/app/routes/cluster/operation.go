@@ -0,0 +1,230 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/klog/v2"
+
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+)
+
+// operationRetention is how long finished operations stay queryable.
+const operationRetention = time.Hour
+
+// operationStep is one unit of work of a cluster operation. rollback undoes what the step created, also when the
+// step failed halfway, and is nil when there is nothing to undo. It must leave objects that existed before alone.
+type operationStep struct {
+	name     string
+	run      func(ctx context.Context) error
+	rollback func(ctx context.Context) error
+}
+
+type operationStore struct {
+	sync.Mutex
+	operations map[string]*v1.ClusterOperation
+	// watchers are notified, without blocking, every time the operation they watch changes.
+	watchers map[string][]chan struct{}
+}
+
+var operations = &operationStore{
+	operations: map[string]*v1.ClusterOperation{},
+	watchers:   map[string][]chan struct{}{},
+}
+
+// start registers a new operation of user on clusterName and runs its steps in the background. It refuses to start
+// while another operation on the same cluster is still running.
+func (s *operationStore) start(user string, opType v1.ClusterOperationType, clusterName string, syncMode clusterv1alpha1.ClusterSyncMode, steps []operationStep) (*v1.ClusterOperation, error) {
+	s.Lock()
+	defer s.Unlock()
+	s.prune()
+	for _, op := range s.operations {
+		if op.ClusterName == clusterName && op.Phase == v1.ClusterOperationRunning {
+			return nil, fmt.Errorf("an operation on cluster %s is still running", clusterName)
+		}
+	}
+
+	op := &v1.ClusterOperation{
+		ID:          fmt.Sprintf("%s-%s-%s", strings.ToLower(string(opType)), clusterName, rand.String(5)),
+		User:        user,
+		Type:        opType,
+		ClusterName: clusterName,
+		SyncMode:    syncMode,
+		Phase:       v1.ClusterOperationRunning,
+		Steps:       make([]v1.ClusterOperationStep, len(steps)),
+		StartTime:   metav1.Now(),
+	}
+	for i, step := range steps {
+		op.Steps[i] = v1.ClusterOperationStep{Name: step.name, Phase: v1.ClusterOperationPending}
+	}
+	s.operations[op.ID] = op
+
+	go s.run(op.ID, steps)
+	return copyOperation(op), nil
+}
+
+// run executes the steps in order. When a step fails, the completed steps are rolled back in reverse order.
+func (s *operationStore) run(id string, steps []operationStep) {
+	ctx := context.Background()
+	failed := -1
+	var runErr error
+	for i, step := range steps {
+		s.updateStep(id, i, v1.ClusterOperationRunning, "")
+		if runErr = step.run(ctx); runErr != nil {
+			klog.ErrorS(runErr, "Cluster operation step failed", "operation", id, "step", step.name)
+			s.updateStep(id, i, v1.ClusterOperationFailed, runErr.Error())
+			failed = i
+			break
+		}
+		s.updateStep(id, i, v1.ClusterOperationSucceeded, "")
+	}
+
+	// the failed step may have done part of its work, so it is rolled back as well
+	for i := failed; i >= 0; i-- {
+		if steps[i].rollback == nil {
+			continue
+		}
+		if err := steps[i].rollback(ctx); err != nil {
+			klog.ErrorS(err, "Cluster operation rollback failed", "operation", id, "step", steps[i].name)
+			s.updateStep(id, i, "", fmt.Sprintf("rollback failed: %v", err))
+			continue
+		}
+		if i != failed {
+			s.updateStep(id, i, v1.ClusterOperationRolledBack, "")
+		}
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	op := s.operations[id]
+	now := metav1.Now()
+	op.CompletionTime = &now
+	if runErr != nil {
+		op.Phase = v1.ClusterOperationFailed
+		op.Error = runErr.Error()
+	} else {
+		op.Phase = v1.ClusterOperationSucceeded
+	}
+	s.notify(id)
+}
+
+// updateStep records the progress of a step, an empty phase only updates the message.
+func (s *operationStore) updateStep(id string, index int, phase v1.ClusterOperationPhase, message string) {
+	s.Lock()
+	defer s.Unlock()
+	step := &s.operations[id].Steps[index]
+	now := metav1.Now()
+	switch phase {
+	case v1.ClusterOperationRunning:
+		step.StartTime = &now
+	case v1.ClusterOperationSucceeded, v1.ClusterOperationFailed:
+		step.CompletionTime = &now
+	}
+	if phase != "" {
+		step.Phase = phase
+	}
+	if message != "" {
+		step.Message = message
+	}
+	s.notify(id)
+}
+
+// get returns the operation if user started it.
+func (s *operationStore) get(id, user string) (*v1.ClusterOperation, bool) {
+	s.Lock()
+	defer s.Unlock()
+	op, ok := s.operations[id]
+	if !ok || op.User != user {
+		return nil, false
+	}
+	return copyOperation(op), true
+}
+
+// list returns the operations user started, newest first.
+func (s *operationStore) list(user string) []v1.ClusterOperation {
+	s.Lock()
+	defer s.Unlock()
+	s.prune()
+	list := make([]v1.ClusterOperation, 0)
+	for _, op := range s.operations {
+		if op.User != user {
+			continue
+		}
+		list = append(list, *copyOperation(op))
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[j].StartTime.Before(&list[i].StartTime)
+	})
+	return list
+}
+
+// watch returns a channel signalled whenever the operation changes, and a func to stop watching.
+func (s *operationStore) watch(id string) (<-chan struct{}, func()) {
+	s.Lock()
+	defer s.Unlock()
+	ch := make(chan struct{}, 1)
+	s.watchers[id] = append(s.watchers[id], ch)
+	return ch, func() {
+		s.Lock()
+		defer s.Unlock()
+		watchers := s.watchers[id]
+		for i := range watchers {
+			if watchers[i] == ch {
+				s.watchers[id] = append(watchers[:i], watchers[i+1:]...)
+				break
+			}
+		}
+		if len(s.watchers[id]) == 0 {
+			delete(s.watchers, id)
+		}
+	}
+}
+
+// notify must be called with the lock held.
+func (s *operationStore) notify(id string) {
+	for _, ch := range s.watchers[id] {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// prune drops operations finished longer than operationRetention ago, it must be called with the lock held.
+func (s *operationStore) prune() {
+	for id, op := range s.operations {
+		if op.CompletionTime != nil && time.Since(op.CompletionTime.Time) > operationRetention {
+			delete(s.operations, id)
+		}
+	}
+}
+
+func copyOperation(op *v1.ClusterOperation) *v1.ClusterOperation {
+	out := *op
+	out.Steps = make([]v1.ClusterOperationStep, len(op.Steps))
+	copy(out.Steps, op.Steps)
+	return &out
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+)
+
+func TestOperationStoreRollsBackCompletedSteps(t *testing.T) {
+	store := &operationStore{
+		operations: map[string]*v1.ClusterOperation{},
+		watchers:   map[string][]chan struct{}{},
+	}
+	var rolledBack []string
+	step := func(name string, runErr error) operationStep {
+		return operationStep{
+			name: name,
+			run:  func(context.Context) error { return runErr },
+			rollback: func(context.Context) error {
+				rolledBack = append(rolledBack, name)
+				return nil
+			},
+		}
+	}
+
+	op, err := store.start("alice", v1.ClusterOperationJoin, "member1", clusterv1alpha1.Pull, []operationStep{
+		step("Secret", nil),
+		step("RBAC", nil),
+		step("AgentDeployment", errors.New("image pull failed")),
+		step("ClusterReady", nil),
+	})
+	if err != nil {
+		t.Fatalf("start() error = %v", err)
+	}
+	if _, err = store.start("alice", v1.ClusterOperationJoin, "member1", clusterv1alpha1.Pull, nil); err == nil {
+		t.Errorf("start() accepted a second operation on a cluster with one running")
+	}
+
+	if _, ok := store.get(op.ID, "bob"); ok {
+		t.Errorf("get() returned the operation to another user")
+	}
+	if list := store.list("bob"); len(list) != 0 {
+		t.Errorf("list() returned %d operations to another user", len(list))
+	}
+
+	op = waitForOperation(t, store, op.ID, "alice")
+	if op.Phase != v1.ClusterOperationFailed || op.Error != "image pull failed" {
+		t.Errorf("operation phase = %s, error = %q", op.Phase, op.Error)
+	}
+	var phases []v1.ClusterOperationPhase
+	for _, s := range op.Steps {
+		phases = append(phases, s.Phase)
+	}
+	wantPhases := []v1.ClusterOperationPhase{
+		v1.ClusterOperationRolledBack,
+		v1.ClusterOperationRolledBack,
+		v1.ClusterOperationFailed,
+		v1.ClusterOperationPending,
+	}
+	if !reflect.DeepEqual(phases, wantPhases) {
+		t.Errorf("step phases = %v, want %v", phases, wantPhases)
+	}
+	if want := []string{"AgentDeployment", "RBAC", "Secret"}; !reflect.DeepEqual(rolledBack, want) {
+		t.Errorf("rolled back = %v, want %v", rolledBack, want)
+	}
+}
+
+// waitForOperation waits until the operation id of user has finished and returns it.
+func waitForOperation(t *testing.T, store *operationStore, id, user string) *v1.ClusterOperation {
+	t.Helper()
+	changed, stop := store.watch(id)
+	defer stop()
+	for {
+		op, ok := store.get(id, user)
+		if !ok {
+			t.Fatalf("operation %s not found", id)
+		}
+		if op.Phase != v1.ClusterOperationRunning {
+			return op
+		}
+		select {
+		case <-changed:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("operation %s did not finish", id)
+		}
+	}
+}
@@ -0,0 +1,203 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	karmadautil "github.com/karmada-io/karmada/pkg/util"
+	"github.com/karmada-io/karmada/pkg/util/names"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	kubeclient "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/pkg/client"
+)
+
+type unjoinOption struct {
+	karmadaClient          karmadaclientset.Interface
+	controlPlaneKubeClient kubeclient.Interface
+	clusterName            string
+	// memberClusterKubeConfig takes precedence over useClusterCredentials.
+	memberClusterKubeConfig string
+	useClusterCredentials   bool
+	// agentNamespace is discovered from the karmada-agent Deployment when empty.
+	agentNamespace string
+
+	cluster             *clusterv1alpha1.Cluster
+	memberClusterClient kubeclient.Interface
+}
+
+// fullUnjoinSteps extends unjoinSteps with the removal of what joining left in the member cluster and of the
+// credentials kept in the control plane, so the cluster can be joined again.
+func fullUnjoinSteps(opts *unjoinOption) []operationStep {
+	steps := []operationStep{{
+		name: "Validate",
+		run: func(ctx context.Context) error {
+			cluster, err := opts.karmadaClient.ClusterV1alpha1().Clusters().Get(ctx, opts.clusterName, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			opts.cluster = cluster
+			// the member client is built now, with cluster credentials it can't be once the Cluster is gone
+			opts.memberClusterClient, err = opts.memberClient(ctx)
+			return err
+		},
+	}}
+	steps = append(steps, unjoinSteps(opts.karmadaClient, opts.clusterName)...)
+	return append(steps,
+		operationStep{
+			name: "CleanupMemberCluster",
+			run:  opts.cleanupMemberCluster,
+		},
+		operationStep{
+			name: "CleanupControlPlane",
+			run:  opts.cleanupControlPlane,
+		},
+	)
+}
+
+// memberClient returns a client for the member cluster, from the given kubeconfig or from the credentials
+// the control plane itself uses for the cluster. The latter dials the cluster's Spec.APIEndpoint directly,
+// through Spec.ProxyURL if set, as the Karmada cluster proxy is gone once the Cluster is deleted.
+func (o *unjoinOption) memberClient(ctx context.Context) (kubeclient.Interface, error) {
+	if o.memberClusterKubeConfig != "" {
+		return client.KubeClientSetFromKubeConfig(o.memberClusterKubeConfig)
+	}
+	if !o.useClusterCredentials {
+		return nil, fmt.Errorf("either the member cluster kubeconfig or useClusterCredentials is required")
+	}
+	if o.cluster.Spec.SecretRef == nil {
+		return nil, fmt.Errorf("cluster %s reports no credentials, the member cluster kubeconfig is required", o.clusterName)
+	}
+	secret, err := o.controlPlaneKubeClient.CoreV1().Secrets(o.cluster.Spec.SecretRef.Namespace).Get(ctx, o.cluster.Spec.SecretRef.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	config := &rest.Config{
+		Host:        o.cluster.Spec.APIEndpoint,
+		BearerToken: string(secret.Data[clusterv1alpha1.SecretTokenKey]),
+		TLSClientConfig: rest.TLSClientConfig{
+			CAData:   secret.Data[clusterv1alpha1.SecretCADataKey],
+			Insecure: o.cluster.Spec.InsecureSkipTLSVerification,
+		},
+	}
+	if o.cluster.Spec.ProxyURL != "" {
+		proxyURL, err := url.Parse(o.cluster.Spec.ProxyURL)
+		if err != nil {
+			return nil, err
+		}
+		config.Proxy = http.ProxyURL(proxyURL)
+	}
+	return kubeclient.NewForConfig(config)
+}
+
+// cleanupMemberCluster removes karmada-agent and the credentials karmada created in the member cluster.
+// With the cluster's own credentials, i.e. without a kubeconfig, removing the ClusterRoleBinding revokes them,
+// so it goes last and the ServiceAccount, ClusterRole and namespace behind them are left in place.
+func (o *unjoinOption) cleanupMemberCluster(ctx context.Context) error {
+	if o.cluster.Spec.SyncMode == clusterv1alpha1.Pull {
+		if err := o.cleanupKarmadaAgent(ctx); err != nil {
+			return err
+		}
+	}
+
+	serviceAccountName := names.GenerateServiceAccountName(o.clusterName)
+	clusterRoleName := names.GenerateRoleName(serviceAccountName)
+	if err := karmadautil.DeleteServiceAccount(o.memberClusterClient, ClusterNamespace, names.GenerateServiceAccountName("impersonator")); err != nil {
+		return err
+	}
+	if o.memberClusterKubeConfig == "" {
+		klog.InfoS("Unjoining with the cluster's own credentials, its ServiceAccount, ClusterRole and namespace are left in the member cluster",
+			"cluster", o.clusterName, "serviceAccount", serviceAccountName, "clusterRole", clusterRoleName, "namespace", ClusterNamespace)
+		return karmadautil.DeleteClusterRoleBinding(o.memberClusterClient, clusterRoleName)
+	}
+
+	if err := karmadautil.DeleteClusterRoleBinding(o.memberClusterClient, clusterRoleName); err != nil {
+		return err
+	}
+	if err := karmadautil.DeleteClusterRole(o.memberClusterClient, clusterRoleName); err != nil {
+		return err
+	}
+	if err := karmadautil.DeleteServiceAccount(o.memberClusterClient, ClusterNamespace, serviceAccountName); err != nil {
+		return err
+	}
+	// only a namespace karmada created is removed
+	namespace, err := o.memberClusterClient.CoreV1().Namespaces().Get(ctx, ClusterNamespace, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if namespace.Labels[karmadautil.KarmadaSystemLabel] != karmadautil.KarmadaSystemLabelValue {
+		return nil
+	}
+	return karmadautil.DeleteNamespace(o.memberClusterClient, ClusterNamespace)
+}
+
+func (o *unjoinOption) cleanupKarmadaAgent(ctx context.Context) error {
+	namespace := o.agentNamespace
+	if namespace == "" {
+		deployments, err := o.memberClusterClient.AppsV1().Deployments(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+			LabelSelector: labels.SelectorFromSet(karmadaAgentLabels).String(),
+		})
+		if err != nil {
+			return err
+		}
+		for _, deployment := range deployments.Items {
+			if deployment.Name == KarmadaAgentName {
+				namespace = deployment.Namespace
+				break
+			}
+		}
+	}
+	if namespace == "" {
+		klog.Infof("No %s deployment found in cluster %s, skipping its cleanup", KarmadaAgentName, o.clusterName)
+		return nil
+	}
+
+	err := o.memberClusterClient.AppsV1().Deployments(namespace).Delete(ctx, KarmadaAgentName, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	if err = karmadautil.DeleteSecret(o.memberClusterClient, namespace, KarmadaKubeconfigName); err != nil {
+		return err
+	}
+	return deleteKarmadaAgentRBAC(o.memberClusterClient, namespace)
+}
+
+// cleanupControlPlane removes the credential secrets of the cluster that karmada leaves in the control plane.
+func (o *unjoinOption) cleanupControlPlane(_ context.Context) error {
+	for _, ref := range []*clusterv1alpha1.LocalSecretReference{o.cluster.Spec.SecretRef, o.cluster.Spec.ImpersonatorSecretRef} {
+		if ref == nil {
+			continue
+		}
+		if err := karmadautil.DeleteSecret(o.controlPlaneKubeClient, ref.Namespace, ref.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
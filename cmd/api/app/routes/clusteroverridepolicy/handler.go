@@ -77,6 +77,8 @@ func handlePostClusterOverridePolicy(c *gin.Context) {
 		common.Fail(c, err)
 		return
 	}
+	dryRun := common.ParseDryRunParameter(c)
+	var created interface{}
 	if overridepolicyRequest.IsClusterScope {
 		clusterOverridePolicy := v1alpha1.ClusterOverridePolicy{}
 		if err = yaml.Unmarshal([]byte(overridepolicyRequest.OverrideData), &clusterOverridePolicy); err != nil {
@@ -84,7 +86,7 @@ func handlePostClusterOverridePolicy(c *gin.Context) {
 			common.Fail(c, err)
 			return
 		}
-		_, err = karmadaClient.PolicyV1alpha1().ClusterOverridePolicies().Create(ctx, &clusterOverridePolicy, metav1.CreateOptions{})
+		created, err = karmadaClient.PolicyV1alpha1().ClusterOverridePolicies().Create(ctx, &clusterOverridePolicy, metav1.CreateOptions{DryRun: dryRun})
 	} else {
 		overridePolicy := v1alpha1.OverridePolicy{}
 		if err = yaml.Unmarshal([]byte(overridepolicyRequest.OverrideData), &overridePolicy); err != nil {
@@ -92,13 +94,17 @@ func handlePostClusterOverridePolicy(c *gin.Context) {
 			common.Fail(c, err)
 			return
 		}
-		_, err = karmadaClient.PolicyV1alpha1().OverridePolicies(overridepolicyRequest.Namespace).Create(ctx, &overridePolicy, metav1.CreateOptions{})
+		created, err = karmadaClient.PolicyV1alpha1().OverridePolicies(overridepolicyRequest.Namespace).Create(ctx, &overridePolicy, metav1.CreateOptions{DryRun: dryRun})
 	}
 	if err != nil {
 		klog.ErrorS(err, "Failed to create OverridePolicy")
 		common.Fail(c, err)
 		return
 	}
+	if dryRun != nil {
+		common.DryRunSuccess(c, nil, created)
+		return
+	}
 	common.Success(c, "ok")
 }
 
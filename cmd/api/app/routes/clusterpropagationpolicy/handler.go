@@ -77,6 +77,8 @@ func handlePostClusterPropagationPolicy(c *gin.Context) {
 		common.Fail(c, err)
 		return
 	}
+	dryRun := common.ParseDryRunParameter(c)
+	var created interface{}
 	if propagationpolicyRequest.IsClusterScope {
 		clusterPropagationPolicy := v1alpha1.ClusterPropagationPolicy{}
 		if err = yaml.Unmarshal([]byte(propagationpolicyRequest.PropagationData), &clusterPropagationPolicy); err != nil {
@@ -84,7 +86,7 @@ func handlePostClusterPropagationPolicy(c *gin.Context) {
 			common.Fail(c, err)
 			return
 		}
-		_, err = karmadaClient.PolicyV1alpha1().ClusterPropagationPolicies().Create(ctx, &clusterPropagationPolicy, metav1.CreateOptions{})
+		created, err = karmadaClient.PolicyV1alpha1().ClusterPropagationPolicies().Create(ctx, &clusterPropagationPolicy, metav1.CreateOptions{DryRun: dryRun})
 	} else {
 		propagationPolicy := v1alpha1.PropagationPolicy{}
 		if err = yaml.Unmarshal([]byte(propagationpolicyRequest.PropagationData), &propagationPolicy); err != nil {
@@ -92,13 +94,17 @@ func handlePostClusterPropagationPolicy(c *gin.Context) {
 			common.Fail(c, err)
 			return
 		}
-		_, err = karmadaClient.PolicyV1alpha1().PropagationPolicies(propagationpolicyRequest.Namespace).Create(ctx, &propagationPolicy, metav1.CreateOptions{})
+		created, err = karmadaClient.PolicyV1alpha1().PropagationPolicies(propagationpolicyRequest.Namespace).Create(ctx, &propagationPolicy, metav1.CreateOptions{DryRun: dryRun})
 	}
 	if err != nil {
 		klog.ErrorS(err, "Failed to create PropagationPolicy")
 		common.Fail(c, err)
 		return
 	}
+	if dryRun != nil {
+		common.DryRunSuccess(c, nil, created)
+		return
+	}
 	common.Success(c, "ok")
 }
 
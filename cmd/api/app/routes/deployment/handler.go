@@ -59,11 +59,16 @@ func handlerCreateDeployment(c *gin.Context) {
 		common.Fail(c, err)
 		return
 	}
-	result, err := clientset.AppsV1().Deployments(createDeploymentRequest.Namespace).Create(ctx, &deployment, metav1.CreateOptions{})
+	dryRun := common.ParseDryRunParameter(c)
+	result, err := clientset.AppsV1().Deployments(createDeploymentRequest.Namespace).Create(ctx, &deployment, metav1.CreateOptions{DryRun: dryRun})
 	if err != nil {
 		common.Fail(c, err)
 		return
 	}
+	if dryRun != nil {
+		common.DryRunSuccess(c, nil, result)
+		return
+	}
 	common.Success(c, result)
 }
 
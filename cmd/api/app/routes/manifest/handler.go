@@ -0,0 +1,76 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"github.com/gin-gonic/gin"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/restmapper"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/resource/manifest"
+)
+
+// handleApplyManifests server-side applies every object of a multi-document manifest and reports per-object
+// outcomes. Namespaces and CRDs go first, dryRun=true applies nothing.
+func handleApplyManifests(c *gin.Context) {
+	applyRequest := new(v1.ApplyManifestsRequest)
+	if err := c.ShouldBind(applyRequest); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	if applyRequest.Namespace == "" {
+		applyRequest.Namespace = "default"
+	}
+	objects, err := manifest.ParseManifests([]byte(applyRequest.Content))
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+
+	k8sClient, err := router.GetKubeClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	dynamicClient, err := client.GetDynamicClientFromRequest(c.Request)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	mapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(k8sClient.Discovery()))
+
+	result := manifest.ApplyManifests(c.Request.Context(), dynamicClient, mapper, objects, manifest.ApplyOptions{
+		DefaultNamespace:  applyRequest.Namespace,
+		ForceConflicts:    applyRequest.ForceConflicts,
+		DryRun:            common.ParseDryRunParameter(c),
+		PropagationPolicy: applyRequest.PropagationPolicy,
+	})
+	if result.Failed > 0 {
+		klog.InfoS("Some manifests failed to apply", "succeeded", result.Succeeded, "failed", result.Failed)
+	}
+	common.Success(c, result)
+}
+
+func init() {
+	r := router.V1()
+	r.POST("/manifest/apply", handleApplyManifests)
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterrole
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/cmd/kubernetes-dashboard-api/pkg/resource/clusterrole"
+)
+
+// return a cluster role list
+func handleGetMemberClusterRole(c *gin.Context) {
+	memberClient, err := router.GetMemberClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	dataSelect := common.ParseMemberDataSelectPathParameter(c)
+	result, err := clusterrole.GetClusterRoleList(memberClient, dataSelect)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+// return a cluster role detail
+func handleGetMemberClusterRoleDetail(c *gin.Context) {
+	memberClient, err := router.GetMemberClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	name := c.Param("name")
+	result, err := clusterrole.GetClusterRoleDetail(memberClient, name)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func init() {
+	r := router.MemberV1()
+	r.GET("/clusterrole", handleGetMemberClusterRole)
+	r.GET("/clusterrole/:name", handleGetMemberClusterRoleDetail)
+}
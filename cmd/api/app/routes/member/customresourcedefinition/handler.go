@@ -0,0 +1,115 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package customresourcedefinition
+
+import (
+	"github.com/gin-gonic/gin"
+	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	"k8s.io/client-go/rest"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/cmd/kubernetes-dashboard-api/pkg/resource/customresourcedefinition"
+	"github.com/karmada-io/dashboard/pkg/client"
+)
+
+// memberExtensionsClient returns an apiextensions client for the member cluster in the path together with
+// the rest config it was built from, the custom resource objects are read through that config.
+func memberExtensionsClient(c *gin.Context) (apiextensionsclientset.Interface, *rest.Config, error) {
+	config, err := client.RestConfigForMemberClusterFromRequest(c.Request, c.Param("clustername"))
+	if err != nil {
+		return nil, nil, err
+	}
+	extensionsClient, err := apiextensionsclientset.NewForConfig(config)
+	if err != nil {
+		return nil, nil, err
+	}
+	return extensionsClient, config, nil
+}
+
+// return a custom resource definition list
+func handleGetMemberCustomResourceDefinition(c *gin.Context) {
+	extensionsClient, _, err := memberExtensionsClient(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	dataSelect := common.ParseMemberDataSelectPathParameter(c)
+	result, err := customresourcedefinition.GetCustomResourceDefinitionList(extensionsClient, dataSelect)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+// return a custom resource definition detail
+func handleGetMemberCustomResourceDefinitionDetail(c *gin.Context) {
+	extensionsClient, config, err := memberExtensionsClient(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	result, err := customresourcedefinition.GetCustomResourceDefinitionDetail(extensionsClient, config, c.Param("crd"))
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+// return a list of objects of the custom resource definition
+func handleGetMemberCustomResourceObject(c *gin.Context) {
+	extensionsClient, config, err := memberExtensionsClient(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	dataSelect := common.ParseMemberDataSelectPathParameter(c)
+	nsQuery := common.ParseMemberNamespacePathParameter(c)
+	result, err := customresourcedefinition.GetCustomResourceObjectList(extensionsClient, config, nsQuery, dataSelect, c.Param("crd"))
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+// return an object detail of the custom resource definition
+func handleGetMemberCustomResourceObjectDetail(c *gin.Context) {
+	extensionsClient, config, err := memberExtensionsClient(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	nsQuery := common.ParseMemberNamespacePathParameter(c)
+	result, err := customresourcedefinition.GetCustomResourceObjectDetail(extensionsClient, nsQuery, config, c.Param("crd"), c.Param("object"))
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func init() {
+	r := router.MemberV1()
+	r.GET("/crd", handleGetMemberCustomResourceDefinition)
+	r.GET("/crd/:crd", handleGetMemberCustomResourceDefinitionDetail)
+	r.GET("/crd/:crd/object", handleGetMemberCustomResourceObject)
+	r.GET("/crd/:crd/object/:namespace", handleGetMemberCustomResourceObject)
+	r.GET("/crd/:crd/object/:namespace/:object", handleGetMemberCustomResourceObjectDetail)
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package daemonset
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/cmd/kubernetes-dashboard-api/pkg/resource/daemonset"
+)
+
+// return a daemonset list
+func handleGetMemberDaemonSet(c *gin.Context) {
+	memberClient, err := router.GetMemberClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	dataSelect := common.ParseMemberDataSelectPathParameter(c)
+	nsQuery := common.ParseMemberNamespacePathParameter(c)
+	result, err := daemonset.GetDaemonSetList(memberClient, nsQuery, dataSelect, nil)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+// return a daemonset detail
+func handleGetMemberDaemonSetDetail(c *gin.Context) {
+	memberClient, err := router.GetMemberClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	namespace := c.Param("namespace")
+	name := c.Param("name")
+	result, err := daemonset.GetDaemonSetDetail(memberClient, nil, namespace, name)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func init() {
+	r := router.MemberV1()
+	r.GET("/daemonset", handleGetMemberDaemonSet)
+	r.GET("/daemonset/:namespace", handleGetMemberDaemonSet)
+	r.GET("/daemonset/:namespace/:name", handleGetMemberDaemonSetDetail)
+}
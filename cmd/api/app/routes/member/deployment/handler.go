@@ -21,13 +21,16 @@ import (
 
 	"github.com/karmada-io/dashboard/cmd/api/app/router"
 	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
-	"github.com/karmada-io/dashboard/pkg/client"
 	"github.com/karmada-io/dashboard/pkg/resource/deployment"
 	"github.com/karmada-io/dashboard/pkg/resource/event"
 )
 
 func handleGetMemberDeployments(c *gin.Context) {
-	memberClient := client.InClusterClientForMemberCluster(c.Param("clustername"))
+	memberClient, err := router.GetMemberClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
 	namespace := common.ParseNamespacePathParameter(c)
 	dataSelect := common.ParseDataSelectPathParameter(c)
 	result, err := deployment.GetDeploymentList(memberClient, namespace, dataSelect)
@@ -39,7 +42,11 @@ func handleGetMemberDeployments(c *gin.Context) {
 }
 
 func handleGetMemberDeploymentDetail(c *gin.Context) {
-	memberClient := client.InClusterClientForMemberCluster(c.Param("clustername"))
+	memberClient, err := router.GetMemberClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
 	namespace := c.Param("namespace")
 	name := c.Param("deployment")
 	result, err := deployment.GetDeploymentDetail(memberClient, namespace, name)
@@ -51,7 +58,11 @@ func handleGetMemberDeploymentDetail(c *gin.Context) {
 }
 
 func handleGetMemberDeploymentEvents(c *gin.Context) {
-	memberClient := client.InClusterClientForMemberCluster(c.Param("clustername"))
+	memberClient, err := router.GetMemberClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
 	namespace := c.Param("namespace")
 	name := c.Param("deployment")
 	dataSelect := common.ParseDataSelectPathParameter(c)
@@ -21,14 +21,16 @@ import (
 
 	"github.com/karmada-io/dashboard/cmd/api/app/router"
 	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
-	"github.com/karmada-io/dashboard/pkg/client"
 	"github.com/karmada-io/dashboard/pkg/resource/event"
 	ns "github.com/karmada-io/dashboard/pkg/resource/namespace"
 )
 
 func handleGetMemberNamespace(c *gin.Context) {
-	memberClient := client.InClusterClientForMemberCluster(c.Param("clustername"))
-
+	memberClient, err := router.GetMemberClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
 	dataSelect := common.ParseDataSelectPathParameter(c)
 	result, err := ns.GetNamespaceList(memberClient, dataSelect)
 	if err != nil {
@@ -39,8 +41,11 @@ func handleGetMemberNamespace(c *gin.Context) {
 }
 
 func handleGetMemberNamespaceDetail(c *gin.Context) {
-	memberClient := client.InClusterClientForMemberCluster(c.Param("clustername"))
-
+	memberClient, err := router.GetMemberClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
 	name := c.Param("name")
 	result, err := ns.GetNamespaceDetail(memberClient, name)
 	if err != nil {
@@ -51,8 +56,11 @@ func handleGetMemberNamespaceDetail(c *gin.Context) {
 }
 
 func handleGetMemberNamespaceEvents(c *gin.Context) {
-	memberClient := client.InClusterClientForMemberCluster(c.Param("clustername"))
-
+	memberClient, err := router.GetMemberClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
 	name := c.Param("name")
 	dataSelect := common.ParseDataSelectPathParameter(c)
 	result, err := event.GetNamespaceEvents(memberClient, dataSelect, name)
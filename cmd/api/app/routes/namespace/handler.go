@@ -41,10 +41,16 @@ func handleCreateNamespace(c *gin.Context) {
 		Name:                createNamespaceRequest.Name,
 		SkipAutoPropagation: createNamespaceRequest.SkipAutoPropagation,
 	}
-	if err := ns.CreateNamespace(spec, k8sClient); err != nil {
+	dryRun := common.ParseDryRunParameter(c)
+	created, err := ns.CreateNamespace(spec, k8sClient, dryRun)
+	if err != nil {
 		common.Fail(c, err)
 		return
 	}
+	if dryRun != nil {
+		common.DryRunSuccess(c, nil, created)
+		return
+	}
 	common.Success(c, "ok")
 }
 func handleGetNamespaces(c *gin.Context) {
@@ -29,6 +29,7 @@ import (
 	"github.com/karmada-io/dashboard/cmd/api/app/router"
 	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
 	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/client"
 	"github.com/karmada-io/dashboard/pkg/common/errors"
 	"github.com/karmada-io/dashboard/pkg/resource/overridepolicy"
 )
@@ -87,6 +88,8 @@ func handlePostOverridePolicy(c *gin.Context) {
 		common.Fail(c, err)
 		return
 	}
+	dryRun := common.ParseDryRunParameter(c)
+	var created interface{}
 	if overridepolicyRequest.IsClusterScope {
 		clusteroverridePolicy := v1alpha1.ClusterOverridePolicy{}
 		if err = yaml.Unmarshal([]byte(overridepolicyRequest.OverrideData), &clusteroverridePolicy); err != nil {
@@ -94,7 +97,7 @@ func handlePostOverridePolicy(c *gin.Context) {
 			common.Fail(c, err)
 			return
 		}
-		_, err = karmadaClient.PolicyV1alpha1().ClusterOverridePolicies().Create(ctx, &clusteroverridePolicy, metav1.CreateOptions{})
+		created, err = karmadaClient.PolicyV1alpha1().ClusterOverridePolicies().Create(ctx, &clusteroverridePolicy, metav1.CreateOptions{DryRun: dryRun})
 	} else {
 		overridePolicy := v1alpha1.OverridePolicy{}
 		if err = yaml.Unmarshal([]byte(overridepolicyRequest.OverrideData), &overridePolicy); err != nil {
@@ -102,13 +105,17 @@ func handlePostOverridePolicy(c *gin.Context) {
 			common.Fail(c, err)
 			return
 		}
-		_, err = karmadaClient.PolicyV1alpha1().OverridePolicies(overridepolicyRequest.Namespace).Create(ctx, &overridePolicy, metav1.CreateOptions{})
+		created, err = karmadaClient.PolicyV1alpha1().OverridePolicies(overridepolicyRequest.Namespace).Create(ctx, &overridePolicy, metav1.CreateOptions{DryRun: dryRun})
 	}
 	if err != nil {
 		klog.ErrorS(err, "Failed to create OverridePolicies")
 		common.Fail(c, err)
 		return
 	}
+	if dryRun != nil {
+		common.DryRunSuccess(c, nil, created)
+		return
+	}
 	common.Success(c, "ok")
 }
 func handlePutOverridePolicy(c *gin.Context) {
@@ -123,6 +130,8 @@ func handlePutOverridePolicy(c *gin.Context) {
 		common.Fail(c, err)
 		return
 	}
+	dryRun := common.ParseDryRunParameter(c)
+	var live, updated interface{}
 	// todo check pp exist
 	if overridepolicyRequest.IsClusterScope {
 		clusteroverridePolicy := v1alpha1.ClusterOverridePolicy{}
@@ -131,7 +140,12 @@ func handlePutOverridePolicy(c *gin.Context) {
 			common.Fail(c, err)
 			return
 		}
-		_, err = karmadaClient.PolicyV1alpha1().ClusterOverridePolicies().Update(ctx, &clusteroverridePolicy, metav1.UpdateOptions{})
+		if dryRun != nil {
+			live, err = karmadaClient.PolicyV1alpha1().ClusterOverridePolicies().Get(ctx, clusteroverridePolicy.Name, metav1.GetOptions{})
+		}
+		if err == nil {
+			updated, err = karmadaClient.PolicyV1alpha1().ClusterOverridePolicies().Update(ctx, &clusteroverridePolicy, metav1.UpdateOptions{DryRun: dryRun})
+		}
 	} else {
 		overridePolicy := v1alpha1.OverridePolicy{}
 		if err = yaml.Unmarshal([]byte(overridepolicyRequest.OverrideData), &overridePolicy); err != nil {
@@ -145,7 +159,8 @@ func handlePutOverridePolicy(c *gin.Context) {
 			// only spec can be updated
 			overridePolicy.TypeMeta = oldOverridePolicy.TypeMeta
 			overridePolicy.ObjectMeta = oldOverridePolicy.ObjectMeta
-			_, err = karmadaClient.PolicyV1alpha1().OverridePolicies(overridepolicyRequest.Namespace).Update(ctx, &overridePolicy, metav1.UpdateOptions{})
+			live = oldOverridePolicy
+			updated, err = karmadaClient.PolicyV1alpha1().OverridePolicies(overridepolicyRequest.Namespace).Update(ctx, &overridePolicy, metav1.UpdateOptions{DryRun: dryRun})
 		}
 	}
 	if err != nil {
@@ -153,8 +168,54 @@ func handlePutOverridePolicy(c *gin.Context) {
 		common.Fail(c, err)
 		return
 	}
+	if dryRun != nil {
+		common.DryRunSuccess(c, live, updated)
+		return
+	}
 	common.Success(c, "ok")
 }
+
+// handleRenderOverridePolicy returns the manifest a resource template gets in a member cluster
+// after every matching ClusterOverridePolicy and OverridePolicy, with the diff of each override.
+func handleRenderOverridePolicy(c *gin.Context) {
+	ctx := context.Context(c)
+	renderRequest := new(v1.RenderOverridePolicyRequest)
+	if err := c.ShouldBindQuery(renderRequest); err != nil {
+		common.Fail(c, err)
+		return
+	}
+
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	k8sClient, err := router.GetKubeClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	dynamicClient, err := client.GetDynamicClientFromRequest(c.Request)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+
+	template, err := overridepolicy.GetResourceTemplate(ctx, k8sClient.Discovery(), dynamicClient,
+		renderRequest.APIVersion, renderRequest.Kind, renderRequest.Namespace, renderRequest.Name)
+	if err != nil {
+		klog.ErrorS(err, "Failed to get resource template")
+		common.Fail(c, err)
+		return
+	}
+	result, err := overridepolicy.RenderOverridePolicies(ctx, karmadaClient, template, renderRequest.Cluster)
+	if err != nil {
+		klog.ErrorS(err, "Failed to render OverridePolicies")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
 func handleDeleteOverridePolicy(c *gin.Context) {
 	ctx := context.Context(c)
 	overridepolicyRequest := new(v1.DeleteOverridePolicyRequest)
@@ -167,29 +228,47 @@ func handleDeleteOverridePolicy(c *gin.Context) {
 		common.Fail(c, err)
 		return
 	}
+	dryRun := common.ParseDryRunParameter(c)
+	var live interface{}
 	if overridepolicyRequest.IsClusterScope {
-		err = karmadaClient.PolicyV1alpha1().ClusterOverridePolicies().Delete(ctx, overridepolicyRequest.Name, metav1.DeleteOptions{})
+		if dryRun != nil {
+			live, err = karmadaClient.PolicyV1alpha1().ClusterOverridePolicies().Get(ctx, overridepolicyRequest.Name, metav1.GetOptions{})
+		}
+		if err == nil {
+			err = karmadaClient.PolicyV1alpha1().ClusterOverridePolicies().Delete(ctx, overridepolicyRequest.Name, metav1.DeleteOptions{DryRun: dryRun})
+		}
 		if err != nil {
 			klog.ErrorS(err, "Failed to delete ClusterOverridePolicy")
 			common.Fail(c, err)
 			return
 		}
 	} else {
-		err = karmadaClient.PolicyV1alpha1().OverridePolicies(overridepolicyRequest.Namespace).Delete(ctx, overridepolicyRequest.Name, metav1.DeleteOptions{})
+		if dryRun != nil {
+			live, err = karmadaClient.PolicyV1alpha1().OverridePolicies(overridepolicyRequest.Namespace).Get(ctx, overridepolicyRequest.Name, metav1.GetOptions{})
+		}
+		if err == nil {
+			err = karmadaClient.PolicyV1alpha1().OverridePolicies(overridepolicyRequest.Namespace).Delete(ctx, overridepolicyRequest.Name, metav1.DeleteOptions{DryRun: dryRun})
+		}
 		if err != nil {
 			klog.ErrorS(err, "Failed to delete OverridePolicy")
 			common.Fail(c, err)
 			return
 		}
-		_ = retry.OnError(
-			retry.DefaultRetry,
-			func(err error) bool {
-				return errors.IsNotFound(err)
-			},
-			func() error {
-				_, getErr := karmadaClient.PolicyV1alpha1().OverridePolicies(overridepolicyRequest.Namespace).Get(ctx, overridepolicyRequest.Name, metav1.GetOptions{})
-				return getErr
-			})
+		if dryRun == nil {
+			_ = retry.OnError(
+				retry.DefaultRetry,
+				func(err error) bool {
+					return errors.IsNotFound(err)
+				},
+				func() error {
+					_, getErr := karmadaClient.PolicyV1alpha1().OverridePolicies(overridepolicyRequest.Namespace).Get(ctx, overridepolicyRequest.Name, metav1.GetOptions{})
+					return getErr
+				})
+		}
+	}
+	if dryRun != nil {
+		common.DryRunSuccess(c, live, nil)
+		return
 	}
 
 	common.Success(c, "ok")
@@ -198,6 +277,8 @@ func handleDeleteOverridePolicy(c *gin.Context) {
 func init() {
 	r := router.V1()
 	r.GET("/overridepolicy", handleGetOverridePolicyList)
+	// "_render" can never clash with a namespace name served by /overridepolicy/:namespace.
+	r.GET("/overridepolicy/_render", handleRenderOverridePolicy)
 	r.GET("/overridepolicy/:namespace", handleGetOverridePolicyList)
 	r.GET("/overridepolicy/namespace/:namespace/:overridePolicyName", handleGetOverridePolicyDetail)
 	r.POST("/overridepolicy", handlePostOverridePolicy)
@@ -29,6 +29,7 @@ import (
 	"github.com/karmada-io/dashboard/cmd/api/app/router"
 	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
 	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/client"
 	"github.com/karmada-io/dashboard/pkg/common/errors"
 	"github.com/karmada-io/dashboard/pkg/resource/propagationpolicy"
 )
@@ -87,6 +88,8 @@ func handlePostPropagationPolicy(c *gin.Context) {
 		common.Fail(c, err)
 		return
 	}
+	dryRun := common.ParseDryRunParameter(c)
+	var created interface{}
 	if propagationpolicyRequest.IsClusterScope {
 		clusterpropagationPolicy := v1alpha1.ClusterPropagationPolicy{}
 		if err = yaml.Unmarshal([]byte(propagationpolicyRequest.PropagationData), &clusterpropagationPolicy); err != nil {
@@ -94,7 +97,7 @@ func handlePostPropagationPolicy(c *gin.Context) {
 			common.Fail(c, err)
 			return
 		}
-		_, err = karmadaClient.PolicyV1alpha1().ClusterPropagationPolicies().Create(ctx, &clusterpropagationPolicy, metav1.CreateOptions{})
+		created, err = karmadaClient.PolicyV1alpha1().ClusterPropagationPolicies().Create(ctx, &clusterpropagationPolicy, metav1.CreateOptions{DryRun: dryRun})
 	} else {
 		propagationPolicy := v1alpha1.PropagationPolicy{}
 		if err = yaml.Unmarshal([]byte(propagationpolicyRequest.PropagationData), &propagationPolicy); err != nil {
@@ -102,13 +105,17 @@ func handlePostPropagationPolicy(c *gin.Context) {
 			common.Fail(c, err)
 			return
 		}
-		_, err = karmadaClient.PolicyV1alpha1().PropagationPolicies(propagationpolicyRequest.Namespace).Create(ctx, &propagationPolicy, metav1.CreateOptions{})
+		created, err = karmadaClient.PolicyV1alpha1().PropagationPolicies(propagationpolicyRequest.Namespace).Create(ctx, &propagationPolicy, metav1.CreateOptions{DryRun: dryRun})
 	}
 	if err != nil {
 		klog.ErrorS(err, "Failed to create PropagationPolicy")
 		common.Fail(c, err)
 		return
 	}
+	if dryRun != nil {
+		common.DryRunSuccess(c, nil, created)
+		return
+	}
 	common.Success(c, "ok")
 }
 func handlePutPropagationPolicy(c *gin.Context) {
@@ -123,6 +130,8 @@ func handlePutPropagationPolicy(c *gin.Context) {
 		common.Fail(c, err)
 		return
 	}
+	dryRun := common.ParseDryRunParameter(c)
+	var live, updated interface{}
 	// todo check pp exist
 	if propagationpolicyRequest.IsClusterScope {
 		clusterpropagationPolicy := v1alpha1.ClusterPropagationPolicy{}
@@ -131,7 +140,12 @@ func handlePutPropagationPolicy(c *gin.Context) {
 			common.Fail(c, err)
 			return
 		}
-		_, err = karmadaClient.PolicyV1alpha1().ClusterPropagationPolicies().Update(ctx, &clusterpropagationPolicy, metav1.UpdateOptions{})
+		if dryRun != nil {
+			live, err = karmadaClient.PolicyV1alpha1().ClusterPropagationPolicies().Get(ctx, clusterpropagationPolicy.Name, metav1.GetOptions{})
+		}
+		if err == nil {
+			updated, err = karmadaClient.PolicyV1alpha1().ClusterPropagationPolicies().Update(ctx, &clusterpropagationPolicy, metav1.UpdateOptions{DryRun: dryRun})
+		}
 	} else {
 		propagationPolicy := v1alpha1.PropagationPolicy{}
 		if err = yaml.Unmarshal([]byte(propagationpolicyRequest.PropagationData), &propagationPolicy); err != nil {
@@ -145,7 +159,8 @@ func handlePutPropagationPolicy(c *gin.Context) {
 			// only spec can be updated
 			propagationPolicy.TypeMeta = oldPropagationPolicy.TypeMeta
 			propagationPolicy.ObjectMeta = oldPropagationPolicy.ObjectMeta
-			_, err = karmadaClient.PolicyV1alpha1().PropagationPolicies(propagationpolicyRequest.Namespace).Update(ctx, &propagationPolicy, metav1.UpdateOptions{})
+			live = oldPropagationPolicy
+			updated, err = karmadaClient.PolicyV1alpha1().PropagationPolicies(propagationpolicyRequest.Namespace).Update(ctx, &propagationPolicy, metav1.UpdateOptions{DryRun: dryRun})
 		}
 	}
 	if err != nil {
@@ -153,8 +168,76 @@ func handlePutPropagationPolicy(c *gin.Context) {
 		common.Fail(c, err)
 		return
 	}
+	if dryRun != nil {
+		common.DryRunSuccess(c, live, updated)
+		return
+	}
 	common.Success(c, "ok")
 }
+
+// handlePreviewPropagationPolicy evaluates a policy from the same body as handlePostPropagationPolicy
+// against current resource templates and clusters, without applying anything.
+func handlePreviewPropagationPolicy(c *gin.Context) {
+	ctx := context.Context(c)
+	propagationpolicyRequest := new(v1.PostPropagationPolicyRequest)
+	if err := c.ShouldBind(&propagationpolicyRequest); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	if propagationpolicyRequest.Namespace == "" {
+		propagationpolicyRequest.Namespace = "default"
+	}
+
+	karmadaClient, err := router.GetKarmadaClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	k8sClient, err := router.GetKubeClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	dynamicClient, err := client.GetDynamicClientFromRequest(c.Request)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+
+	var policyRef propagationpolicy.PolicyRef
+	var spec v1alpha1.PropagationSpec
+	if propagationpolicyRequest.IsClusterScope {
+		clusterpropagationPolicy := v1alpha1.ClusterPropagationPolicy{}
+		if err = yaml.Unmarshal([]byte(propagationpolicyRequest.PropagationData), &clusterpropagationPolicy); err != nil {
+			klog.ErrorS(err, "Failed to unmarshal ClusterPropagationPolicy")
+			common.Fail(c, err)
+			return
+		}
+		policyRef = propagationpolicy.PolicyRef{Name: clusterpropagationPolicy.Name, IsClusterScope: true}
+		spec = clusterpropagationPolicy.Spec
+	} else {
+		propagationPolicy := v1alpha1.PropagationPolicy{}
+		if err = yaml.Unmarshal([]byte(propagationpolicyRequest.PropagationData), &propagationPolicy); err != nil {
+			klog.ErrorS(err, "Failed to unmarshal PropagationPolicy")
+			common.Fail(c, err)
+			return
+		}
+		namespace := propagationPolicy.Namespace
+		if namespace == "" {
+			namespace = propagationpolicyRequest.Namespace
+		}
+		policyRef = propagationpolicy.PolicyRef{Name: propagationPolicy.Name, Namespace: namespace}
+		spec = propagationPolicy.Spec
+	}
+
+	result, err := propagationpolicy.PreviewPropagationPolicy(ctx, karmadaClient, k8sClient.Discovery(), dynamicClient, policyRef, spec)
+	if err != nil {
+		klog.ErrorS(err, "Failed to preview PropagationPolicy")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
 func handleDeletePropagationPolicy(c *gin.Context) {
 	ctx := context.Context(c)
 	propagationpolicyRequest := new(v1.DeletePropagationPolicyRequest)
@@ -167,29 +250,47 @@ func handleDeletePropagationPolicy(c *gin.Context) {
 		common.Fail(c, err)
 		return
 	}
+	dryRun := common.ParseDryRunParameter(c)
+	var live interface{}
 	if propagationpolicyRequest.IsClusterScope {
-		err = karmadaClient.PolicyV1alpha1().ClusterPropagationPolicies().Delete(ctx, propagationpolicyRequest.Name, metav1.DeleteOptions{})
+		if dryRun != nil {
+			live, err = karmadaClient.PolicyV1alpha1().ClusterPropagationPolicies().Get(ctx, propagationpolicyRequest.Name, metav1.GetOptions{})
+		}
+		if err == nil {
+			err = karmadaClient.PolicyV1alpha1().ClusterPropagationPolicies().Delete(ctx, propagationpolicyRequest.Name, metav1.DeleteOptions{DryRun: dryRun})
+		}
 		if err != nil {
 			klog.ErrorS(err, "Failed to delete PropagationPolicy")
 			common.Fail(c, err)
 			return
 		}
 	} else {
-		err = karmadaClient.PolicyV1alpha1().PropagationPolicies(propagationpolicyRequest.Namespace).Delete(ctx, propagationpolicyRequest.Name, metav1.DeleteOptions{})
+		if dryRun != nil {
+			live, err = karmadaClient.PolicyV1alpha1().PropagationPolicies(propagationpolicyRequest.Namespace).Get(ctx, propagationpolicyRequest.Name, metav1.GetOptions{})
+		}
+		if err == nil {
+			err = karmadaClient.PolicyV1alpha1().PropagationPolicies(propagationpolicyRequest.Namespace).Delete(ctx, propagationpolicyRequest.Name, metav1.DeleteOptions{DryRun: dryRun})
+		}
 		if err != nil {
 			klog.ErrorS(err, "Failed to delete PropagationPolicy")
 			common.Fail(c, err)
 			return
 		}
-		_ = retry.OnError(
-			retry.DefaultRetry,
-			func(err error) bool {
-				return errors.IsNotFound(err)
-			},
-			func() error {
-				_, getErr := karmadaClient.PolicyV1alpha1().PropagationPolicies(propagationpolicyRequest.Namespace).Get(ctx, propagationpolicyRequest.Name, metav1.GetOptions{})
-				return getErr
-			})
+		if dryRun == nil {
+			_ = retry.OnError(
+				retry.DefaultRetry,
+				func(err error) bool {
+					return errors.IsNotFound(err)
+				},
+				func() error {
+					_, getErr := karmadaClient.PolicyV1alpha1().PropagationPolicies(propagationpolicyRequest.Namespace).Get(ctx, propagationpolicyRequest.Name, metav1.GetOptions{})
+					return getErr
+				})
+		}
+	}
+	if dryRun != nil {
+		common.DryRunSuccess(c, live, nil)
+		return
 	}
 
 	common.Success(c, "ok")
@@ -200,6 +301,7 @@ func init() {
 	r.GET("/propagationpolicy", handleGetPropagationPolicyList)
 	r.GET("/propagationpolicy/namespace/:namespace/:propagationPolicyName", handleGetPropagationPolicyDetail)
 	r.POST("/propagationpolicy", handlePostPropagationPolicy)
+	r.POST("/propagationpolicy/preview", handlePreviewPropagationPolicy)
 	r.PUT("/propagationpolicy", handlePutPropagationPolicy)
 	r.DELETE("/propagationpolicy", handleDeletePropagationPolicy)
 }
@@ -17,13 +17,23 @@ limitations under the License.
 package topology
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
 	"github.com/gin-gonic/gin"
+	"k8s.io/klog/v2"
 
 	"github.com/karmada-io/dashboard/cmd/api/app/router"
 	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/client"
 	"github.com/karmada-io/dashboard/pkg/resource/topology"
 )
 
+// sseKeepaliveInterval is how often an idle topology stream is pinged.
+const sseKeepaliveInterval = 20 * time.Second
+
 func handleGetResourceTopology(c *gin.Context) {
 	namespace := c.Param("namespace")
 	name := c.Param("name")
@@ -35,7 +45,13 @@ func handleGetResourceTopology(c *gin.Context) {
 		return
 	}
 
-	result, err := topology.GetResourceTopology(c.Request.Context(), k8sClient, namespace, name, kind)
+	dynamicClient, err := client.GetDynamicClientFromRequest(c.Request)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+
+	result, err := topology.GetResourceTopology(c.Request.Context(), c.Request, k8sClient, dynamicClient, namespace, name, kind)
 
 	if err != nil {
 		common.Fail(c, err)
@@ -45,7 +61,88 @@ func handleGetResourceTopology(c *gin.Context) {
 	common.Success(c, result)
 }
 
+// handleWatchResourceTopology streams topology deltas as Server-Sent Events.
+// Every "delta" event carries a JSON array of node and edge changes, the first one the full graph.
+func handleWatchResourceTopology(c *gin.Context) {
+	namespace := c.Param("namespace")
+	name := c.Param("name")
+	kind := c.Param("kind")
+
+	k8sClient, err := router.GetKubeClientFromContext(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	dynamicClient, err := client.GetDynamicClientFromRequest(c.Request)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+
+	c.Writer.Header().Set("Content-Type", "text/event-stream")
+	c.Writer.Header().Set("Cache-Control", "no-cache")
+	c.Writer.Header().Set("Connection", "keep-alive")
+	c.Writer.Header().Set("X-Accel-Buffering", "no")
+	c.Writer.Flush()
+
+	ctx, cancel := context.WithCancel(c.Request.Context())
+	defer cancel()
+	updates := make(chan []topology.TopologyDelta)
+	done := make(chan error, 1)
+	go func() {
+		done <- topology.WatchResourceTopology(ctx, c.Request, k8sClient, dynamicClient, namespace, name, kind,
+			func(deltas []topology.TopologyDelta) error {
+				select {
+				case updates <- deltas:
+					return nil
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			})
+	}()
+
+	// Comment lines keep idle streams from being cut by proxies while nothing changes.
+	keepalive := time.NewTicker(sseKeepaliveInterval)
+	defer keepalive.Stop()
+	for {
+		select {
+		case deltas := <-updates:
+			if err := sendSSEEvent(c, "delta", deltas); err != nil {
+				return
+			}
+		case <-keepalive.C:
+			if _, err := fmt.Fprint(c.Writer, ": ping\n\n"); err != nil {
+				return
+			}
+			c.Writer.Flush()
+		case err := <-done:
+			if err != nil {
+				klog.ErrorS(err, "Failed to watch resource topology", "kind", kind, "namespace", namespace, "name", name)
+				_ = sendSSEEvent(c, "error", err.Error())
+			}
+			return
+		}
+	}
+}
+
+// sendSSEEvent writes a named SSE event with a JSON payload and flushes it to the client.
+func sendSSEEvent(c *gin.Context, event string, payload interface{}) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal SSE message: %w", err)
+	}
+	if _, err = fmt.Fprintf(c.Writer, "event: %s\ndata: %s\n\n", event, data); err != nil {
+		return err
+	}
+	c.Writer.Flush()
+	return nil
+}
+
 func init() {
 	r := router.V1()
 	r.GET("/topology/:namespace/:kind/:name", handleGetResourceTopology)
+	r.GET("/topology/:namespace/:kind/:name/watch", handleWatchResourceTopology)
+	// Cluster-scoped resource templates, "_cluster" can never clash with a namespace name.
+	r.GET("/topology/_cluster/:kind/:name", handleGetResourceTopology)
+	r.GET("/topology/_cluster/:kind/:name/watch", handleWatchResourceTopology)
 }
@@ -17,11 +17,14 @@ limitations under the License.
 package unstructured
 
 import (
+	"fmt"
 	"io"
+	"mime"
 
 	"github.com/gin-gonic/gin"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/retry"
 	"k8s.io/klog/v2"
 
@@ -30,128 +33,242 @@ import (
 	"github.com/karmada-io/dashboard/pkg/client"
 )
 
-func handleDeleteResource(c *gin.Context) {
-	verber, err := client.VerberClient(c.Request)
-	if err != nil {
-		klog.ErrorS(err, "Failed to init VerberClient")
-		common.Fail(c, err)
-		return
-	}
-	kind := c.Param("kind")
-	namespace := c.Param("namespace")
-	name := c.Param("name")
-	deleteNow := c.Query("deleteNow") == "true"
-
-	if err := verber.Delete(kind, namespace, name, deleteNow); err != nil {
-		klog.ErrorS(err, "Failed to delete resource")
-		common.Fail(c, err)
-		return
-	}
-	err = retry.OnError(
-		retry.DefaultRetry,
-		func(err error) bool {
-			return errors.IsNotFound(err)
-		},
-		func() error {
-			_, getErr := verber.Get(kind, namespace, name)
-			return getErr
-		})
-	if !errors.IsNotFound(err) {
-		klog.ErrorS(err, "Wait for verber delete resource failed")
-		common.Fail(c, err)
-		return
-	}
+func handleDeleteResource(newVerber verberFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		verber, err := newVerber(c)
+		if err != nil {
+			klog.ErrorS(err, "Failed to init VerberClient")
+			common.Fail(c, err)
+			return
+		}
+		kind := c.Param("kind")
+		namespace := c.Param("namespace")
+		name := c.Param("name")
+		deleteNow := c.Query("deleteNow") == "true"
+		dryRun := common.ParseDryRunParameter(c)
 
-	common.Success(c, "ok")
-}
-func handleGetResource(c *gin.Context) {
-	verber, err := client.VerberClient(c.Request)
-	if err != nil {
-		klog.ErrorS(err, "Failed to init VerberClient")
-		common.Fail(c, err)
-		return
+		if err := verber.Delete(kind, namespace, name, deleteNow, dryRun); err != nil {
+			klog.ErrorS(err, "Failed to delete resource")
+			common.Fail(c, err)
+			return
+		}
+		if dryRun != nil {
+			dryRunSuccess(c, verber, nil)
+			return
+		}
+		err = retry.OnError(
+			retry.DefaultRetry,
+			func(err error) bool {
+				return errors.IsNotFound(err)
+			},
+			func() error {
+				_, getErr := verber.Get(kind, namespace, name)
+				return getErr
+			})
+		if !errors.IsNotFound(err) {
+			klog.ErrorS(err, "Wait for verber delete resource failed")
+			common.Fail(c, err)
+			return
+		}
+
+		common.Success(c, "ok")
 	}
-	kind := c.Param("kind")
-	namespace := c.Param("namespace")
-	name := c.Param("name")
+}
 
-	result, err := verber.Get(kind, namespace, name)
-	if err != nil {
-		klog.ErrorS(err, "Failed to get resource")
-		common.Fail(c, err)
-		return
+func handleGetResource(newVerber verberFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		verber, err := newVerber(c)
+		if err != nil {
+			klog.ErrorS(err, "Failed to init VerberClient")
+			common.Fail(c, err)
+			return
+		}
+		kind := c.Param("kind")
+		namespace := c.Param("namespace")
+		name := c.Param("name")
+
+		result, err := verber.Get(kind, namespace, name)
+		if err != nil {
+			klog.ErrorS(err, "Failed to get resource")
+			common.Fail(c, err)
+			return
+		}
+		common.Success(c, result)
 	}
-	common.Success(c, result)
 }
-func handlePutResource(c *gin.Context) {
-	verber, err := client.VerberClient(c.Request)
-	if err != nil {
-		klog.ErrorS(err, "Failed to init VerberClient")
-		common.Fail(c, err)
-		return
+
+func handlePutResource(newVerber verberFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		verber, err := newVerber(c)
+		if err != nil {
+			klog.ErrorS(err, "Failed to init VerberClient")
+			common.Fail(c, err)
+			return
+		}
+
+		raw := &unstructured.Unstructured{}
+		bytes, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			klog.ErrorS(err, "Failed to read request body")
+			common.Fail(c, err)
+			return
+		}
+		err = raw.UnmarshalJSON(bytes)
+		if err != nil {
+			klog.ErrorS(err, "Failed to unmarshal request body")
+			common.Fail(c, err)
+			return
+		}
+		dryRun := common.ParseDryRunParameter(c)
+		result, err := verber.Update(raw, dryRun)
+		if err != nil {
+			klog.ErrorS(err, "Failed to update resource")
+			common.Fail(c, err)
+			return
+		}
+		if dryRun != nil {
+			dryRunSuccess(c, verber, result)
+			return
+		}
+		common.Success(c, "ok")
 	}
+}
 
-	raw := &unstructured.Unstructured{}
-	bytes, err := io.ReadAll(c.Request.Body)
-	if err != nil {
-		klog.ErrorS(err, "Failed to read request body")
-		common.Fail(c, err)
-		return
+func handleCreateResource(newVerber verberFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// todo double-check existence of target resources, if exist return directly.
+		verber, err := newVerber(c)
+		if err != nil {
+			klog.ErrorS(err, "Failed to init VerberClient")
+			common.Fail(c, err)
+			return
+		}
+
+		raw := &unstructured.Unstructured{}
+		bytes, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			klog.ErrorS(err, "Failed to read request body")
+			common.Fail(c, err)
+			return
+		}
+		err = raw.UnmarshalJSON(bytes)
+		if err != nil {
+			klog.ErrorS(err, "Failed to unmarshal request body")
+			common.Fail(c, err)
+			return
+		}
+		dryRun := common.ParseDryRunParameter(c)
+		result, err := verber.Create(raw, dryRun)
+		if err != nil {
+			klog.ErrorS(err, "Failed to create resource")
+			common.Fail(c, err)
+			return
+		}
+		if dryRun != nil {
+			dryRunSuccess(c, verber, result)
+			return
+		}
+		common.Success(c, "ok")
 	}
-	err = raw.UnmarshalJSON(bytes)
+}
+
+// patchTypeFromContentType maps the Content-Type of a PATCH request to the patch type, using the
+// same media types as the Kubernetes apiserver.
+func patchTypeFromContentType(contentType string) (types.PatchType, error) {
+	mediaType, _, err := mime.ParseMediaType(contentType)
 	if err != nil {
-		klog.ErrorS(err, "Failed to unmarshal request body")
-		common.Fail(c, err)
-		return
+		return "", fmt.Errorf("invalid Content-Type %q: %w", contentType, err)
 	}
-	if err = verber.Update(raw); err != nil {
-		klog.ErrorS(err, "Failed to update resource")
-		common.Fail(c, err)
-		return
+	switch patchType := types.PatchType(mediaType); patchType {
+	case types.JSONPatchType, types.MergePatchType, types.StrategicMergePatchType, types.ApplyPatchType:
+		return patchType, nil
+	default:
+		return "", fmt.Errorf("unsupported patch Content-Type %q, expected one of %s, %s, %s or %s", mediaType,
+			types.JSONPatchType, types.MergePatchType, types.StrategicMergePatchType, types.ApplyPatchType)
 	}
-	common.Success(c, "ok")
 }
 
-func handleCreateResource(c *gin.Context) {
-	// todo double-check existence of target resources, if exist return directly.
-	verber, err := client.VerberClient(c.Request)
-	if err != nil {
-		klog.ErrorS(err, "Failed to init VerberClient")
-		common.Fail(c, err)
-		return
-	}
+// handlePatchResource patches a resource in place instead of replacing it, so fields written concurrently by
+// Karmada controllers are kept. The patch type is taken from the Content-Type header, server-side apply
+// takes over conflicting fields with the force=true query.
+func handlePatchResource(newVerber verberFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		verber, err := newVerber(c)
+		if err != nil {
+			klog.ErrorS(err, "Failed to init VerberClient")
+			common.Fail(c, err)
+			return
+		}
+		patchType, err := patchTypeFromContentType(c.ContentType())
+		if err != nil {
+			common.Fail(c, err)
+			return
+		}
+		patch, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			klog.ErrorS(err, "Failed to read request body")
+			common.Fail(c, err)
+			return
+		}
 
-	raw := &unstructured.Unstructured{}
-	bytes, err := io.ReadAll(c.Request.Body)
-	if err != nil {
-		klog.ErrorS(err, "Failed to read request body")
-		common.Fail(c, err)
-		return
+		dryRun := common.ParseDryRunParameter(c)
+		result, err := verber.Patch(c.Param("kind"), c.Param("namespace"), c.Param("name"), patchType, patch, c.Query("force") == "true", dryRun)
+		if err != nil {
+			klog.ErrorS(err, "Failed to patch resource")
+			common.Fail(c, err)
+			return
+		}
+		if dryRun != nil {
+			dryRunSuccess(c, verber, result)
+			return
+		}
+		common.Success(c, result)
 	}
-	err = raw.UnmarshalJSON(bytes)
+}
+
+// dryRunSuccess responds to a dry-run write with the object the apiserver would persist and its diff
+// against the live object named in the path. result is nil for a dry-run delete.
+func dryRunSuccess(c *gin.Context, verber client.ResourceVerber, result *unstructured.Unstructured) {
+	live, err := verber.Get(c.Param("kind"), c.Param("namespace"), c.Param("name"))
 	if err != nil {
-		klog.ErrorS(err, "Failed to unmarshal request body")
-		common.Fail(c, err)
-		return
+		if !errors.IsNotFound(err) {
+			klog.ErrorS(err, "Failed to get live resource")
+			common.Fail(c, err)
+			return
+		}
+		live = nil
 	}
-	if _, err = verber.Create(raw); err != nil {
-		klog.ErrorS(err, "Failed to create resource")
-		common.Fail(c, err)
-		return
-	}
-	common.Success(c, "ok")
+	common.DryRunSuccess(c, live, result)
 }
 
-func init() {
-	r := router.V1()
-	r.DELETE("/_raw/:kind/namespace/:namespace/name/:name", handleDeleteResource)
-	r.GET("/_raw/:kind/namespace/:namespace/name/:name", handleGetResource)
-	r.PUT("/_raw/:kind/namespace/:namespace/name/:name", handlePutResource)
-	r.POST("/_raw/:kind/namespace/:namespace/name/:name", handleCreateResource)
+// verberFunc builds the ResourceVerber a request is served with.
+type verberFunc func(c *gin.Context) (client.ResourceVerber, error)
+
+func karmadaVerber(c *gin.Context) (client.ResourceVerber, error) {
+	return client.VerberClient(c.Request)
+}
+
+func memberVerber(c *gin.Context) (client.ResourceVerber, error) {
+	return client.MemberVerberClient(c.Request, c.Param("clustername"))
+}
+
+func registerRawRoutes(r *gin.RouterGroup, newVerber verberFunc) {
+	r.DELETE("/_raw/:kind/namespace/:namespace/name/:name", handleDeleteResource(newVerber))
+	r.GET("/_raw/:kind/namespace/:namespace/name/:name", handleGetResource(newVerber))
+	r.PUT("/_raw/:kind/namespace/:namespace/name/:name", handlePutResource(newVerber))
+	r.PATCH("/_raw/:kind/namespace/:namespace/name/:name", handlePatchResource(newVerber))
+	r.POST("/_raw/:kind/namespace/:namespace/name/:name", handleCreateResource(newVerber))
 
 	// Verber (non-namespaced)
-	r.DELETE("/_raw/:kind/name/:name", handleDeleteResource)
-	r.GET("/_raw/:kind/name/:name", handleGetResource)
-	r.PUT("/_raw/:kind/name/:name", handlePutResource)
-	r.POST("/_raw/:kind/name/:name", handleCreateResource)
+	r.DELETE("/_raw/:kind/name/:name", handleDeleteResource(newVerber))
+	r.GET("/_raw/:kind/name/:name", handleGetResource(newVerber))
+	r.PUT("/_raw/:kind/name/:name", handlePutResource(newVerber))
+	r.PATCH("/_raw/:kind/name/:name", handlePatchResource(newVerber))
+	r.POST("/_raw/:kind/name/:name", handleCreateResource(newVerber))
+}
+
+func init() {
+	registerRawRoutes(router.V1(), karmadaVerber)
+	// The same verbs against a member cluster, e.g. to clear a stuck finalizer on a member-only object.
+	registerRawRoutes(router.MemberV1(), memberVerber)
 }
@@ -0,0 +1,41 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"time"
+
+	"github.com/karmada-io/dashboard/pkg/audit"
+)
+
+// AuditQueryRequest is the query for recent audit entries, empty fields match every entry.
+type AuditQueryRequest struct {
+	User      string    `form:"user"`
+	Verb      string    `form:"verb"`
+	Resource  string    `form:"resource"`
+	Cluster   string    `form:"cluster"`
+	Namespace string    `form:"namespace"`
+	Since     time.Time `form:"since" time_format:"2006-01-02T15:04:05Z07:00"`
+	Limit     int       `form:"limit" binding:"omitempty,min=1"`
+}
+
+// AuditQueryResponse is the response for recent audit entries, newest first.
+type AuditQueryResponse struct {
+	Entries []audit.Entry `json:"entries"`
+	// OwnEntriesOnly is set when the caller may only read their own entries.
+	OwnEntriesOnly bool `json:"ownEntriesOnly"`
+}
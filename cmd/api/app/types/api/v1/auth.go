@@ -16,6 +16,11 @@ limitations under the License.
 
 package v1
 
+import (
+	"github.com/karmada-io/dashboard/pkg/oidc"
+	"github.com/karmada-io/dashboard/pkg/resource/permission"
+)
+
 // LoginRequest is the request for login.
 type LoginRequest struct {
 	Token string `json:"token"`
@@ -33,6 +38,14 @@ type User struct {
 	PreferredUsername string `json:"preferredUsername,omitempty"`
 	AuthType          string `json:"authType,omitempty"`
 	Authenticated     bool   `json:"authenticated"`
+
+	// Username, UID, Groups and Extra are the identity the Karmada apiserver authenticated the request as.
+	Username string              `json:"username,omitempty"`
+	UID      string              `json:"uid,omitempty"`
+	Groups   []string            `json:"groups,omitempty"`
+	Extra    map[string][]string `json:"extra,omitempty"`
+	// Permissions is what the user may do with the resources the dashboard offers actions on, only set by /me.
+	Permissions *permission.Matrix `json:"permissions,omitempty"`
 }
 
 // ServiceAccount is the service account info.
@@ -41,6 +54,12 @@ type ServiceAccount struct {
 	UID  string `json:"uid"`
 }
 
+// OIDCLoginRequest is the request for OIDC login initiation.
+type OIDCLoginRequest struct {
+	// Provider is the ID of the provider to log in with, optional if there is only one.
+	Provider string `form:"provider"`
+}
+
 // OIDCLoginResponse is the response for OIDC login initiation.
 type OIDCLoginResponse struct {
 	AuthURL string `json:"authUrl"`
@@ -53,12 +72,19 @@ type OIDCCallbackRequest struct {
 	State string `form:"state" binding:"required"`
 }
 
-// OIDCCallbackResponse is the response for OIDC callback.
+// OIDCCallbackResponse is the response for OIDC callback. The session is carried by an HttpOnly cookie.
 type OIDCCallbackResponse struct {
-	Token string `json:"token"`
+	Authenticated bool `json:"authenticated"`
+}
+
+// LogoutResponse is the response for logout.
+type LogoutResponse struct {
+	// EndSessionURL is the identity provider's logout URL for the browser to visit, empty if it has none.
+	EndSessionURL string `json:"endSessionUrl,omitempty"`
 }
 
 // OIDCEnabledResponse is the response for OIDC feature state.
 type OIDCEnabledResponse struct {
-	Enabled bool `json:"enabled"`
+	Enabled   bool                `json:"enabled"`
+	Providers []oidc.ProviderInfo `json:"providers"`
 }
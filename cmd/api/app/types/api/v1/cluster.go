@@ -19,6 +19,8 @@ package v1
 import (
 	"github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
+
+	"github.com/karmada-io/dashboard/pkg/resource/cluster"
 )
 
 // PostClusterRequest is the request body for creating a cluster.
@@ -31,6 +33,24 @@ type PostClusterRequest struct {
 	ClusterProvider         string                   `json:"clusterProvider"`
 	ClusterRegion           string                   `json:"clusterRegion"`
 	ClusterZones            []string                 `json:"clusterZones"`
+	// KarmadaAgent customizes the karmada-agent deployed in Pull mode, unset fields take the dashboard config defaults.
+	KarmadaAgent *KarmadaAgentOptions `json:"karmadaAgent,omitempty"`
+}
+
+// KarmadaAgentOptions is the karmada-agent deployment part of a Pull mode cluster join request.
+type KarmadaAgentOptions struct {
+	Image              string            `json:"image"`
+	Version            string            `json:"version"`
+	ImagePullSecrets   []string          `json:"imagePullSecrets"`
+	Replicas           *int32            `json:"replicas"`
+	ResourceRequests   map[string]string `json:"resourceRequests"`
+	ResourceLimits     map[string]string `json:"resourceLimits"`
+	FeatureGates       map[string]bool   `json:"featureGates"`
+	ProxyServerAddress string            `json:"proxyServerAddress"`
+	HTTPProxy          string            `json:"httpProxy"`
+	HTTPSProxy         string            `json:"httpsProxy"`
+	NoProxy            string            `json:"noProxy"`
+	LogLevel           *int              `json:"logLevel"`
 }
 
 // PostClusterResponse is the response body for creating a cluster.
@@ -59,6 +79,19 @@ type PutClusterRequest struct {
 // PutClusterResponse is the response body for updating a cluster.
 type PutClusterResponse struct{}
 
+// BulkClusterRequest is the request body for changing labels and taints of many clusters at once. Clusters are
+// targeted by name, by label selector, or both.
+type BulkClusterRequest struct {
+	ClusterNames  []string                `json:"clusterNames"`
+	LabelSelector string                  `json:"labelSelector"`
+	Operations    []cluster.BulkOperation `json:"operations" binding:"required"`
+}
+
+// BulkClusterResponse is the response body for a bulk cluster change, with one result per targeted cluster.
+type BulkClusterResponse struct {
+	Results []cluster.BulkResult `json:"results"`
+}
+
 // DeleteClusterRequest is the request body for deleting a cluster.
 type DeleteClusterRequest struct {
 	MemberClusterName string `uri:"name" binding:"required"`
@@ -67,3 +100,14 @@ type DeleteClusterRequest struct {
 // DeleteClusterResponse is the response body for deleting a cluster.
 type DeleteClusterResponse struct {
 }
+
+// UnjoinClusterRequest is the request body for unjoining a cluster and cleaning up the member side. The member
+// cluster is reached with MemberClusterKubeConfig, or with the credentials the control plane holds for it when
+// UseClusterCredentials is set. Those credentials can't remove themselves, so the cluster's ServiceAccount,
+// ClusterRole and namespace are only removed with MemberClusterKubeConfig.
+type UnjoinClusterRequest struct {
+	MemberClusterKubeConfig string `json:"memberClusterKubeconfig"`
+	UseClusterCredentials   bool   `json:"useClusterCredentials"`
+	// AgentNamespace is the namespace of karmada-agent for Pull mode clusters, looked up if empty.
+	AgentNamespace string `json:"agentNamespace"`
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClusterOperationType is the kind of background cluster operation.
+type ClusterOperationType string
+
+const (
+	// ClusterOperationJoin joins a member cluster to Karmada.
+	ClusterOperationJoin ClusterOperationType = "Join"
+	// ClusterOperationUnjoin removes a member cluster from Karmada.
+	ClusterOperationUnjoin ClusterOperationType = "Unjoin"
+)
+
+// ClusterOperationPhase is the phase of a cluster operation or of one of its steps.
+type ClusterOperationPhase string
+
+const (
+	// ClusterOperationPending means the step has not started yet.
+	ClusterOperationPending ClusterOperationPhase = "Pending"
+	// ClusterOperationRunning means the operation or step is in progress.
+	ClusterOperationRunning ClusterOperationPhase = "Running"
+	// ClusterOperationSucceeded means the operation or step completed.
+	ClusterOperationSucceeded ClusterOperationPhase = "Succeeded"
+	// ClusterOperationFailed means the operation or step failed.
+	ClusterOperationFailed ClusterOperationPhase = "Failed"
+	// ClusterOperationRolledBack means the step completed and was undone after a later step failed.
+	ClusterOperationRolledBack ClusterOperationPhase = "RolledBack"
+)
+
+// ClusterOperationStep is the progress of a single step of a cluster operation.
+type ClusterOperationStep struct {
+	Name           string                `json:"name"`
+	Phase          ClusterOperationPhase `json:"phase"`
+	Message        string                `json:"message,omitempty"`
+	StartTime      *metav1.Time          `json:"startTime,omitempty"`
+	CompletionTime *metav1.Time          `json:"completionTime,omitempty"`
+}
+
+// ClusterOperation is a cluster join or unjoin running in the background.
+type ClusterOperation struct {
+	ID string `json:"id"`
+	// User is who started the operation, only they can read it.
+	User           string                   `json:"user"`
+	Type           ClusterOperationType     `json:"type"`
+	ClusterName    string                   `json:"clusterName"`
+	SyncMode       v1alpha1.ClusterSyncMode `json:"syncMode,omitempty"`
+	Phase          ClusterOperationPhase    `json:"phase"`
+	Steps          []ClusterOperationStep   `json:"steps"`
+	Error          string                   `json:"error,omitempty"`
+	StartTime      metav1.Time              `json:"startTime"`
+	CompletionTime *metav1.Time             `json:"completionTime,omitempty"`
+}
+
+// ClusterOperationList is the response body for listing cluster operations.
+type ClusterOperationList struct {
+	Operations []ClusterOperation `json:"operations"`
+}
@@ -0,0 +1,31 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import "github.com/karmada-io/dashboard/pkg/resource/manifest"
+
+// ApplyManifestsRequest defines the request structure for applying manifests.
+type ApplyManifestsRequest struct {
+	// Content is multi-document YAML, or a JSON object or List.
+	Content string `json:"content" binding:"required"`
+	// Namespace is used for namespaced objects that do not set one, "default" if empty.
+	Namespace string `json:"namespace"`
+	// ForceConflicts takes over fields owned by other field managers.
+	ForceConflicts bool `json:"forceConflicts"`
+	// PropagationPolicy, if set, creates a PropagationPolicy for the applied objects.
+	PropagationPolicy *manifest.PropagationPolicyOptions `json:"propagationPolicy,omitempty"`
+}
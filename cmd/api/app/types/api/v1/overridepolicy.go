@@ -49,3 +49,12 @@ type DeleteOverridePolicyRequest struct {
 // DeleteOverridePolicyResponse is the response body for deleting an override policy.
 type DeleteOverridePolicyResponse struct {
 }
+
+// RenderOverridePolicyRequest is the query for rendering a resource template with its override policies applied.
+type RenderOverridePolicyRequest struct {
+	APIVersion string `form:"apiVersion" binding:"required"`
+	Kind       string `form:"kind" binding:"required"`
+	Namespace  string `form:"namespace"`
+	Name       string `form:"name" binding:"required"`
+	Cluster    string `form:"cluster" binding:"required"`
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	dashboardcommon "github.com/karmada-io/dashboard/cmd/kubernetes-dashboard-api/pkg/resource/common"
+	dashboarddataselect "github.com/karmada-io/dashboard/cmd/kubernetes-dashboard-api/pkg/resource/dataselect"
+)
+
+// The member cluster routes reuse the resource implementations of kubernetes-dashboard-api,
+// which come with their own dataselect and namespace query types.
+
+// ParseMemberDataSelectPathParameter parses query parameters of the request and returns a
+// kubernetes-dashboard-api DataSelectQuery object. Metrics are not collected for member clusters.
+func ParseMemberDataSelectPathParameter(request *gin.Context) *dashboarddataselect.DataSelectQuery {
+	paginationQuery := dashboarddataselect.NoPagination
+	itemsPerPage, itemsErr := strconv.ParseInt(request.Query("itemsPerPage"), 10, 0)
+	page, pageErr := strconv.ParseInt(request.Query("page"), 10, 0)
+	if itemsErr == nil && pageErr == nil {
+		// Frontend pages start from 1 and backend starts from 0
+		paginationQuery = dashboarddataselect.NewPaginationQuery(int(itemsPerPage), int(page-1))
+	}
+	sortQuery := dashboarddataselect.NewSortQuery(strings.Split(request.Query("sortBy"), ","))
+	filterQuery := dashboarddataselect.NewFilterQuery(strings.Split(request.Query("filterBy"), ","))
+	return dashboarddataselect.NewDataSelectQuery(paginationQuery, sortQuery, filterQuery, dashboarddataselect.NoMetrics)
+}
+
+// ParseMemberNamespacePathParameter parses the namespace path parameter the same way as
+// ParseNamespacePathParameter and returns a kubernetes-dashboard-api NamespaceQuery object.
+func ParseMemberNamespacePathParameter(request *gin.Context) *dashboardcommon.NamespaceQuery {
+	return dashboardcommon.NewNamespaceQuery(parseNamespaces(request))
+}
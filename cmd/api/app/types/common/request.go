@@ -21,6 +21,7 @@ import (
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/karmada-io/dashboard/pkg/dataselect"
 	"github.com/karmada-io/dashboard/pkg/resource/common"
@@ -58,10 +59,28 @@ func ParseDataSelectPathParameter(request *gin.Context) *dataselect.DataSelectQu
 	return dataselect.NewDataSelectQuery(paginationQuery, sortQuery, filterQuery)
 }
 
+// ParseDryRunParameter returns the DryRun option for a write, []string{metav1.DryRunAll} when the request
+// carries the dryRun=true query and nil otherwise.
+func ParseDryRunParameter(request *gin.Context) []string {
+	if !IsDryRun(request) {
+		return nil
+	}
+	return []string{metav1.DryRunAll}
+}
+
+// IsDryRun reports whether the request carries the dryRun=true query.
+func IsDryRun(request *gin.Context) bool {
+	return request.Query("dryRun") == "true"
+}
+
 // ParseNamespacePathParameter parses namespace selector for list pages in path parameter.
 // The namespace selector is a comma separated list of namespaces that are trimmed.
 // No namespaces mean "view all user namespaces", i.e., everything except kube-system.
 func ParseNamespacePathParameter(request *gin.Context) *common.NamespaceQuery {
+	return common.NewNamespaceQuery(parseNamespaces(request))
+}
+
+func parseNamespaces(request *gin.Context) []string {
 	namespace := request.Param("namespace")
 	namespaces := strings.Split(namespace, ",")
 	var nonEmptyNamespaces []string
@@ -71,5 +90,5 @@ func ParseNamespacePathParameter(request *gin.Context) *common.NamespaceQuery {
 			nonEmptyNamespaces = append(nonEmptyNamespaces, n)
 		}
 	}
-	return common.NewNamespaceQuery(nonEmptyNamespaces)
+	return nonEmptyNamespaces
 }
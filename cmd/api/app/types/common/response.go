@@ -21,6 +21,15 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+
+	"github.com/karmada-io/dashboard/pkg/resource/common"
+)
+
+const (
+	// ResponseCodeKey is the context key Response stores the biz status code under.
+	ResponseCodeKey = "responseCode"
+	// ResponseErrorKey is the context key Response stores the error under, if any.
+	ResponseErrorKey = "responseError"
 )
 
 // BaseResponse is the base response
@@ -40,6 +49,13 @@ func Fail(c *gin.Context, err error) {
 	Response(c, err, nil)
 }
 
+// DryRunSuccess generate the response of a dry-run write, the object the apiserver would persist
+// together with its diff against the live object
+func DryRunSuccess(c *gin.Context, live, object interface{}) {
+	result, err := common.NewDryRunResult(live, object)
+	Response(c, err, result)
+}
+
 // Response generate response
 func Response(c *gin.Context, err error, data interface{}) {
 	code := 200          // biz status code
@@ -47,7 +63,9 @@ func Response(c *gin.Context, err error, data interface{}) {
 	if err != nil {
 		code = 500
 		message = err.Error()
+		c.Set(ResponseErrorKey, err)
 	}
+	c.Set(ResponseCodeKey, code)
 	c.JSON(http.StatusOK, BaseResponse{
 		Code: code,
 		Msg:  message,
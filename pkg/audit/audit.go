@@ -0,0 +1,178 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+// DefaultCapacity is the number of recent entries a Logger keeps for queries if none is given.
+const DefaultCapacity = 1000
+
+// Entry records an action taken through the dashboard.
+type Entry struct {
+	Time time.Time `json:"time"`
+	User User      `json:"user"`
+	// Verb is create, update, patch or delete for requests and call for assistant tool calls.
+	Verb   string `json:"verb"`
+	Method string `json:"method,omitempty"`
+	// Route is the route pattern that served the request, e.g. /api/v1/cluster/:name, or the assistant tool.
+	Route  string `json:"route"`
+	Path   string `json:"path,omitempty"`
+	Target Target `json:"target"`
+	DryRun bool   `json:"dryRun,omitempty"`
+	// RequestDigest is the hex encoded SHA-256 of the request body or tool arguments.
+	RequestDigest string `json:"requestDigest,omitempty"`
+	// ResponseCode is the code of the dashboard's response body, or the HTTP status for responses without one.
+	ResponseCode  int    `json:"responseCode"`
+	Error         string `json:"error,omitempty"`
+	LatencyMillis int64  `json:"latencyMillis"`
+	SourceIP      string `json:"sourceIP,omitempty"`
+}
+
+// User is who took an action, as the Karmada apiserver authenticates them.
+type User struct {
+	Username string   `json:"username,omitempty"`
+	Groups   []string `json:"groups,omitempty"`
+	// Impersonated is set when the dashboard called Karmada with its own credentials, impersonating the user.
+	Impersonated bool `json:"impersonated,omitempty"`
+}
+
+// Target is the object an action was taken on, fields the request does not reveal are left empty.
+type Target struct {
+	Cluster   string `json:"cluster,omitempty"`
+	Resource  string `json:"resource,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name,omitempty"`
+}
+
+// Sink stores audit entries.
+type Sink interface {
+	Write(entry *Entry) error
+	Close() error
+}
+
+// Query selects recent entries, empty fields match every entry.
+type Query struct {
+	Username  string
+	Verb      string
+	Resource  string
+	Cluster   string
+	Namespace string
+	Since     time.Time
+	// Limit caps the number of entries returned, all matching entries are returned if it is not positive.
+	Limit int
+}
+
+func (q *Query) matches(entry *Entry) bool {
+	return (q.Username == "" || q.Username == entry.User.Username) &&
+		(q.Verb == "" || q.Verb == entry.Verb) &&
+		(q.Resource == "" || q.Resource == entry.Target.Resource) &&
+		(q.Cluster == "" || q.Cluster == entry.Target.Cluster) &&
+		(q.Namespace == "" || q.Namespace == entry.Target.Namespace) &&
+		!entry.Time.Before(q.Since)
+}
+
+// Logger writes entries to its sinks and keeps the most recent ones in memory for queries.
+type Logger struct {
+	sinks []Sink
+
+	mu sync.Mutex
+	// recent is a ring buffer, next is the index the next entry is stored at.
+	recent []Entry
+	next   int
+	full   bool
+}
+
+// NewLogger returns a Logger writing to sinks that keeps the last capacity entries, DefaultCapacity if not positive.
+func NewLogger(capacity int, sinks ...Sink) *Logger {
+	if capacity <= 0 {
+		capacity = DefaultCapacity
+	}
+	return &Logger{
+		sinks:  sinks,
+		recent: make([]Entry, capacity),
+	}
+}
+
+// Record stores entry. Sink errors are logged, an action is never refused because it could not be audited.
+func (l *Logger) Record(entry *Entry) {
+	if entry.Time.IsZero() {
+		entry.Time = time.Now()
+	}
+
+	l.mu.Lock()
+	l.recent[l.next] = *entry
+	l.next = (l.next + 1) % len(l.recent)
+	l.full = l.full || l.next == 0
+	l.mu.Unlock()
+
+	for _, sink := range l.sinks {
+		if err := sink.Write(entry); err != nil {
+			klog.ErrorS(err, "Could not write audit entry", "verb", entry.Verb, "route", entry.Route, "user", entry.User.Username)
+		}
+	}
+}
+
+// Query returns the recent entries matching q, newest first.
+func (l *Logger) Query(q Query) []Entry {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	count := l.next
+	if l.full {
+		count = len(l.recent)
+	}
+	entries := make([]Entry, 0)
+	for i := 0; i < count; i++ {
+		entry := &l.recent[(l.next-1-i+len(l.recent))%len(l.recent)]
+		if !q.matches(entry) {
+			continue
+		}
+		entries = append(entries, *entry)
+		if q.Limit > 0 && len(entries) == q.Limit {
+			break
+		}
+	}
+	return entries
+}
+
+// Close closes the sinks.
+func (l *Logger) Close() error {
+	var errs []error
+	for _, sink := range l.sinks {
+		if err := sink.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Digest returns the hex encoded SHA-256 of data, empty for empty data.
+func Digest(data []byte) string {
+	if len(data) == 0 {
+		return ""
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
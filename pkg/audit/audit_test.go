@@ -0,0 +1,87 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+type failingSink struct {
+	writes int
+}
+
+func (s *failingSink) Write(*Entry) error {
+	s.writes++
+	return errors.New("sink unavailable")
+}
+
+func (s *failingSink) Close() error {
+	return nil
+}
+
+func TestLoggerQuery(t *testing.T) {
+	sink := &failingSink{}
+	logger := NewLogger(3, sink)
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i, user := range []string{"alice", "bob", "alice", "carol", "alice"} {
+		logger.Record(&Entry{
+			Time:   start.Add(time.Duration(i) * time.Minute),
+			User:   User{Username: user},
+			Verb:   "delete",
+			Target: Target{Resource: "cluster", Name: user},
+		})
+	}
+	if sink.writes != 5 {
+		t.Errorf("sink writes = %d, want 5 despite errors", sink.writes)
+	}
+
+	tests := []struct {
+		name  string
+		query Query
+		want  []time.Duration
+	}{
+		{name: "only the last entries are kept, newest first", query: Query{}, want: []time.Duration{4, 3, 2}},
+		{name: "by user", query: Query{Username: "alice"}, want: []time.Duration{4, 2}},
+		{name: "limit", query: Query{Limit: 1}, want: []time.Duration{4}},
+		{name: "since", query: Query{Since: start.Add(3 * time.Minute)}, want: []time.Duration{4, 3}},
+		{name: "no match", query: Query{Verb: "create"}, want: []time.Duration{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := logger.Query(tt.query)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Query() returned %d entries, want %d", len(got), len(tt.want))
+			}
+			for i, minutes := range tt.want {
+				if want := start.Add(minutes * time.Minute); !got[i].Time.Equal(want) {
+					t.Errorf("entry %d time = %v, want %v", i, got[i].Time, want)
+				}
+			}
+		})
+	}
+}
+
+func TestDigest(t *testing.T) {
+	if got := Digest(nil); got != "" {
+		t.Errorf("Digest(nil) = %q, want empty", got)
+	}
+	if got, want := Digest([]byte("abc")), "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"; got != want {
+		t.Errorf("Digest(abc) = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+// webhookQueueSize bounds the entries a webhook sink buffers while the webhook is slow or unreachable.
+const webhookQueueSize = 1000
+
+// errSinkClosed is returned for entries written after their sink was closed, e.g. by a request still being
+// served at shutdown.
+var errSinkClosed = errors.New("audit sink is closed")
+
+// streamSink writes entries as JSON lines.
+type streamSink struct {
+	mu      sync.Mutex
+	encoder *json.Encoder
+	closer  io.Closer
+	closed  bool
+}
+
+// NewStreamSink returns a Sink writing one JSON entry per line to w, e.g. os.Stdout.
+func NewStreamSink(w io.Writer) Sink {
+	return &streamSink{encoder: json.NewEncoder(w)}
+}
+
+// NewFileSink returns a Sink appending one JSON entry per line to the file at path, which is created if missing.
+func NewFileSink(path string) (Sink, error) {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log file: %w", err)
+	}
+	return &streamSink{encoder: json.NewEncoder(file), closer: file}, nil
+}
+
+func (s *streamSink) Write(entry *Entry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return errSinkClosed
+	}
+	return s.encoder.Encode(entry)
+}
+
+func (s *streamSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed || s.closer == nil {
+		s.closed = true
+		return nil
+	}
+	s.closed = true
+	return s.closer.Close()
+}
+
+// webhookSink posts entries to a webhook from a background goroutine, so a slow webhook does not slow down
+// the dashboard. Entries are dropped, and the drop logged, when the queue is full.
+type webhookSink struct {
+	url    string
+	client *http.Client
+	done   chan struct{}
+
+	// mu guards queue against sends after Close closed it.
+	mu     sync.Mutex
+	queue  chan *Entry
+	closed bool
+}
+
+// NewWebhookSink returns a Sink posting each entry as JSON to url.
+func NewWebhookSink(url string, timeout time.Duration) Sink {
+	s := &webhookSink{
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+		queue:  make(chan *Entry, webhookQueueSize),
+		done:   make(chan struct{}),
+	}
+	go s.run()
+	return s
+}
+
+func (s *webhookSink) Write(entry *Entry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return errSinkClosed
+	}
+	select {
+	case s.queue <- entry:
+		return nil
+	default:
+		return fmt.Errorf("audit webhook queue is full, dropping entry")
+	}
+}
+
+func (s *webhookSink) run() {
+	defer close(s.done)
+	for entry := range s.queue {
+		if err := s.post(entry); err != nil {
+			klog.ErrorS(err, "Could not send audit entry to webhook", "verb", entry.Verb, "route", entry.Route, "user", entry.User.Username)
+		}
+	}
+}
+
+func (s *webhookSink) post(entry *Entry) error {
+	body, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	request, err := http.NewRequestWithContext(context.Background(), http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	response, err := s.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	_, _ = io.Copy(io.Discard, response.Body)
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("audit webhook responded with %s", response.Status)
+	}
+	return nil
+}
+
+// Close sends the queued entries and stops the sink.
+func (s *webhookSink) Close() error {
+	s.mu.Lock()
+	if !s.closed {
+		s.closed = true
+		close(s.queue)
+	}
+	s.mu.Unlock()
+	<-s.done
+	return nil
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	for _, name := range []string{"first", "second"} {
+		// Reopening appends, so entries survive restarts.
+		sink, err := NewFileSink(path)
+		if err != nil {
+			t.Fatalf("NewFileSink() error = %v", err)
+		}
+		if err := sink.Write(&Entry{Verb: "create", Target: Target{Name: name}}); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+		if err := sink.Close(); err != nil {
+			t.Fatalf("Close() error = %v", err)
+		}
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	var names []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var entry Entry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			t.Fatalf("line %q is not an entry: %v", scanner.Text(), err)
+		}
+		names = append(names, entry.Target.Name)
+	}
+	if len(names) != 2 || names[0] != "first" || names[1] != "second" {
+		t.Errorf("entries = %v, want [first second]", names)
+	}
+}
+
+func TestWebhookSink(t *testing.T) {
+	received := make(chan Entry, 2)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var entry Entry
+		if err := json.NewDecoder(r.Body).Decode(&entry); err != nil {
+			t.Errorf("webhook received an invalid entry: %v", err)
+		}
+		received <- entry
+	}))
+	defer server.Close()
+
+	sink := NewWebhookSink(server.URL, time.Second)
+	for _, user := range []string{"alice", "bob"} {
+		if err := sink.Write(&Entry{User: User{Username: user}}); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+	}
+	// Close waits for the queued entries to be sent.
+	if err := sink.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	close(received)
+	var users []string
+	for entry := range received {
+		users = append(users, entry.User.Username)
+	}
+	if len(users) != 2 || users[0] != "alice" || users[1] != "bob" {
+		t.Errorf("webhook received %v, want [alice bob]", users)
+	}
+}
+
+func TestSinkWriteAfterClose(t *testing.T) {
+	fileSink, err := NewFileSink(filepath.Join(t.TempDir(), "audit.log"))
+	if err != nil {
+		t.Fatalf("NewFileSink() error = %v", err)
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	defer server.Close()
+
+	for name, sink := range map[string]Sink{
+		"file":    fileSink,
+		"webhook": NewWebhookSink(server.URL, time.Second),
+	} {
+		if err := sink.Close(); err != nil {
+			t.Fatalf("%s: Close() error = %v", name, err)
+		}
+		// Requests still being served at shutdown may write after Close.
+		if err := sink.Write(&Entry{Verb: "delete"}); err != errSinkClosed {
+			t.Errorf("%s: Write() after Close error = %v, want %v", name, err, errSinkClosed)
+		}
+		if err := sink.Close(); err != nil {
+			t.Errorf("%s: second Close() error = %v", name, err)
+		}
+	}
+}
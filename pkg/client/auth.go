@@ -17,10 +17,15 @@ limitations under the License.
 package client
 
 import (
+	"context"
 	"net/http"
 	"strings"
 
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authenticationv1beta1 "k8s.io/api/authentication/v1beta1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeclient "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
@@ -33,6 +38,47 @@ const (
 	authorizationTokenPrefix = "Bearer "
 )
 
+// impersonatedUserContextKey carries the user a request impersonates, see WithImpersonatedUser.
+type impersonatedUserContextKey struct{}
+
+// ImpersonatedUser is a user the dashboard authenticated itself, and calls Karmada for with its own credentials.
+type ImpersonatedUser struct {
+	Name   string
+	Groups []string
+}
+
+// WithImpersonatedUser returns a shallow copy of request whose clients act with the dashboard's own credentials
+// while impersonating user. The caller's bearer token and impersonation headers are ignored for such requests.
+func WithImpersonatedUser(request *http.Request, user *ImpersonatedUser) *http.Request {
+	return request.WithContext(context.WithValue(request.Context(), impersonatedUserContextKey{}, user))
+}
+
+// ImpersonatedUserFromRequest returns the user request impersonates with the dashboard's credentials, or nil.
+func ImpersonatedUserFromRequest(request *http.Request) *ImpersonatedUser {
+	user, _ := request.Context().Value(impersonatedUserContextKey{}).(*ImpersonatedUser)
+	return user
+}
+
+// ReviewSelf asks the apiserver who kubeClient is authenticated as.
+func ReviewSelf(ctx context.Context, kubeClient kubeclient.Interface) (*authenticationv1.UserInfo, error) {
+	review, err := kubeClient.AuthenticationV1().SelfSubjectReviews().Create(ctx,
+		&authenticationv1.SelfSubjectReview{}, metav1.CreateOptions{})
+	if err == nil {
+		return &review.Status.UserInfo, nil
+	}
+	if !k8serrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	// authentication.k8s.io/v1 SelfSubjectReviews are only served from Kubernetes 1.28 on
+	betaReview, err := kubeClient.AuthenticationV1beta1().SelfSubjectReviews().Create(ctx,
+		&authenticationv1beta1.SelfSubjectReview{}, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return &betaReview.Status.UserInfo, nil
+}
+
 func restConfigFromRequest(request *http.Request) (*rest.Config, error) {
 	authInfo, err := buildAuthInfo(request)
 	if err != nil {
@@ -68,6 +114,12 @@ func buildConfigFromAuthInfo(authInfo *clientcmdapi.AuthInfo) (*rest.Config, err
 }
 
 func buildAuthInfo(request *http.Request) (*clientcmdapi.AuthInfo, error) {
+	if user := ImpersonatedUserFromRequest(request); user != nil {
+		authInfo := dashboardAuthInfo()
+		authInfo.Impersonate = user.Name
+		authInfo.ImpersonateGroups = user.Groups
+		return authInfo, nil
+	}
 	if !HasAuthorizationHeader(request) {
 		return nil, k8serrors.NewUnauthorized("MSG_LOGIN_UNAUTHORIZED_ERROR")
 	}
@@ -82,6 +134,23 @@ func buildAuthInfo(request *http.Request) (*clientcmdapi.AuthInfo, error) {
 	return authInfo, nil
 }
 
+// dashboardAuthInfo returns the dashboard's own credentials for the Karmada apiserver.
+func dashboardAuthInfo() *clientcmdapi.AuthInfo {
+	return &clientcmdapi.AuthInfo{
+		Token:                 karmadaRestConfig.BearerToken,
+		TokenFile:             karmadaRestConfig.BearerTokenFile,
+		ClientCertificate:     karmadaRestConfig.TLSClientConfig.CertFile,
+		ClientCertificateData: karmadaRestConfig.TLSClientConfig.CertData,
+		ClientKey:             karmadaRestConfig.TLSClientConfig.KeyFile,
+		ClientKeyData:         karmadaRestConfig.TLSClientConfig.KeyData,
+		Username:              karmadaRestConfig.Username,
+		Password:              karmadaRestConfig.Password,
+		Exec:                  karmadaRestConfig.ExecProvider,
+		AuthProvider:          karmadaRestConfig.AuthProvider,
+		ImpersonateUserExtra:  make(map[string][]string),
+	}
+}
+
 // HasAuthorizationHeader checks if the request has an authorization header.
 func HasAuthorizationHeader(req *http.Request) bool {
 	header := req.Header.Get(authorizationHeader)
@@ -21,6 +21,7 @@ import (
 	"reflect"
 	"testing"
 
+	"k8s.io/client-go/rest"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 )
 
@@ -169,3 +170,28 @@ func TestHandleImpersonation(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildAuthInfoForImpersonatedUser(t *testing.T) {
+	previous := karmadaRestConfig
+	karmadaRestConfig = &rest.Config{Host: "https://karmada-apiserver:5443", BearerToken: "dashboard-token"}
+	defer func() { karmadaRestConfig = previous }()
+
+	req := httptest.NewRequest("GET", "/", nil)
+	SetAuthorizationHeader(req, "id-token")
+	req.Header[ImpersonateUserHeader] = []string{"system:admin"}
+	req = WithImpersonatedUser(req, &ImpersonatedUser{Name: "oidc:alice@example.com", Groups: []string{"oidc:dev"}})
+
+	authInfo, err := buildAuthInfo(req)
+	if err != nil {
+		t.Fatalf("buildAuthInfo() error = %v", err)
+	}
+	expected := &clientcmdapi.AuthInfo{
+		Token:                "dashboard-token",
+		Impersonate:          "oidc:alice@example.com",
+		ImpersonateGroups:    []string{"oidc:dev"},
+		ImpersonateUserExtra: make(map[string][]string),
+	}
+	if !reflect.DeepEqual(authInfo, expected) {
+		t.Errorf("buildAuthInfo() = %+v, want %+v", authInfo, expected)
+	}
+}
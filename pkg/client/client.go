@@ -21,6 +21,7 @@ import (
 	"net/http"
 
 	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"k8s.io/client-go/dynamic"
 	kubeclient "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
@@ -144,42 +145,24 @@ func karmadaClientForKarmadaAPIServerFromRequest(request *http.Request) (kubecli
 	return kubeclient.NewForConfig(config)
 }
 
-// GetClientForMemberClusterFromRequest creates a Kubernetes clientset from an HTTP request
-// for a member cluster APIServer, based on `Authorization` header
-func GetClientForMemberClusterFromRequest(request *http.Request) (kubeclient.Interface, error) {
+// GetDynamicClientFromRequest creates a dynamic client from an HTTP request
+// for the Karmada APIServer, based on `Authorization` header
+func GetDynamicClientFromRequest(request *http.Request) (dynamic.Interface, error) {
 	if !isKarmadaInitialized() {
 		return nil, fmt.Errorf("client package not initialized")
 	}
-
-	memberClusterName := request.Header.Get(MemberClusterHeaderName)
-	if memberClusterName == "" {
-		return nil, fmt.Errorf("member cluster name is empty")
-	}
-
-	// Load and return Interface for member apiserver if already exist
-	if value, ok := memberClients.Load(memberClusterName); ok {
-		if clientForMemberAPIServer, ok := value.(kubeclient.Interface); ok {
-			return clientForMemberAPIServer, nil
-		}
-		return nil, fmt.Errorf("load client for member apiserver error")
-	}
-	clientForMemberAPIServer, err := clientForMemberClusterAPIServer(request, memberClusterName)
-	if err != nil {
-		klog.ErrorS(err, "Could not init kubernetes in-cluster client for member apiserver")
-		return nil, fmt.Errorf("could not init kubernetes in-cluster client for member apiserver")
-	}
-	memberClients.Store(memberClusterName, clientForMemberAPIServer)
-	return clientForMemberAPIServer, nil
-}
-
-func clientForMemberClusterAPIServer(request *http.Request, memberClusterName string) (kubeclient.Interface, error) {
 	config, err := restConfigFromRequest(request)
 	if err != nil {
 		return nil, err
 	}
 
-	config.Host = config.Host + fmt.Sprintf(proxyURL, memberClusterName)
-	return kubeclient.NewForConfig(config)
+	return dynamic.NewForConfig(config)
+}
+
+// GetClientForMemberClusterFromRequest creates a Kubernetes clientset from an HTTP request
+// for the member cluster APIServer named in the member cluster header, based on `Authorization` header
+func GetClientForMemberClusterFromRequest(request *http.Request) (kubeclient.Interface, error) {
+	return ClientForMemberClusterFromRequest(request, request.Header.Get(MemberClusterHeaderName))
 }
 
 // ConfigForMemberClusterFromRequest creates a rest.Config from an HTTP request
@@ -0,0 +1,178 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"k8s.io/client-go/dynamic"
+	kubeclient "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// memberClientTTL bounds how long a member cluster client built from the caller's credentials is reused,
+// so a revoked token or a changed impersonation does not linger in the cache.
+const memberClientTTL = 10 * time.Minute
+
+// memberClientKey identifies a cached member cluster client, credential is a hash of the caller's
+// bearer token and impersonation so the credentials themselves are never used as map keys.
+type memberClientKey struct {
+	cluster    string
+	credential string
+}
+
+type memberClientEntry struct {
+	config        *rest.Config
+	client        kubeclient.Interface
+	dynamicClient dynamic.Interface
+	expiresAt     time.Time
+}
+
+// memberClientCache caches the clients a caller uses to reach a member cluster through the karmada
+// cluster proxy, keyed by cluster and credential hash.
+type memberClientCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	now     func() time.Time
+	entries map[memberClientKey]*memberClientEntry
+}
+
+var memberClientsFromRequest = newMemberClientCache(memberClientTTL)
+
+func newMemberClientCache(ttl time.Duration) *memberClientCache {
+	return &memberClientCache{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: map[memberClientKey]*memberClientEntry{},
+	}
+}
+
+// get returns the cached clients of the member cluster for authInfo, building them when they are missing or expired.
+func (m *memberClientCache) get(clusterName string, authInfo *clientcmdapi.AuthInfo) (*memberClientEntry, error) {
+	key := memberClientKey{cluster: clusterName, credential: credentialHash(authInfo)}
+	now := m.now()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if entry, ok := m.entries[key]; ok && now.Before(entry.expiresAt) {
+		return entry, nil
+	}
+
+	config, err := buildConfigFromAuthInfo(authInfo)
+	if err != nil {
+		return nil, err
+	}
+	config.Host = config.Host + fmt.Sprintf(proxyURL, clusterName)
+	c, err := kubeclient.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	for k, entry := range m.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(m.entries, k)
+		}
+	}
+	entry := &memberClientEntry{
+		config:        config,
+		client:        c,
+		dynamicClient: dynamicClient,
+		expiresAt:     now.Add(m.ttl),
+	}
+	m.entries[key] = entry
+	return entry, nil
+}
+
+// credentialHash hashes everything in authInfo that decides who the caller is to the member apiserver.
+func credentialHash(authInfo *clientcmdapi.AuthInfo) string {
+	h := sha256.New()
+	write := func(s string) {
+		h.Write([]byte(s))
+		h.Write([]byte{0})
+	}
+	write(authInfo.Token)
+	write(authInfo.Impersonate)
+	for _, group := range authInfo.ImpersonateGroups {
+		write("group=" + group)
+	}
+	extraNames := make([]string, 0, len(authInfo.ImpersonateUserExtra))
+	for name := range authInfo.ImpersonateUserExtra {
+		extraNames = append(extraNames, name)
+	}
+	sort.Strings(extraNames)
+	for _, name := range extraNames {
+		for _, value := range authInfo.ImpersonateUserExtra[name] {
+			write("extra=" + name + "=" + value)
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func memberClientEntryFromRequest(request *http.Request, memberClusterName string) (*memberClientEntry, error) {
+	if !isKarmadaInitialized() {
+		return nil, fmt.Errorf("client package not initialized")
+	}
+	if memberClusterName == "" {
+		return nil, fmt.Errorf("member cluster name is empty")
+	}
+	authInfo, err := buildAuthInfo(request)
+	if err != nil {
+		return nil, err
+	}
+	return memberClientsFromRequest.get(memberClusterName, authInfo)
+}
+
+// ClientForMemberClusterFromRequest returns a Kubernetes clientset for the named member cluster APIServer that
+// acts with the caller's bearer token and impersonation headers. Clients are cached per cluster and credentials.
+func ClientForMemberClusterFromRequest(request *http.Request, memberClusterName string) (kubeclient.Interface, error) {
+	entry, err := memberClientEntryFromRequest(request, memberClusterName)
+	if err != nil {
+		return nil, err
+	}
+	return entry.client, nil
+}
+
+// DynamicClientForMemberClusterFromRequest returns a dynamic client for the named member cluster APIServer that
+// acts with the caller's bearer token and impersonation headers.
+func DynamicClientForMemberClusterFromRequest(request *http.Request, memberClusterName string) (dynamic.Interface, error) {
+	entry, err := memberClientEntryFromRequest(request, memberClusterName)
+	if err != nil {
+		return nil, err
+	}
+	return entry.dynamicClient, nil
+}
+
+// RestConfigForMemberClusterFromRequest returns a rest.Config for the named member cluster APIServer that
+// carries the caller's bearer token and impersonation headers.
+func RestConfigForMemberClusterFromRequest(request *http.Request, memberClusterName string) (*rest.Config, error) {
+	entry, err := memberClientEntryFromRequest(request, memberClusterName)
+	if err != nil {
+		return nil, err
+	}
+	return rest.CopyConfig(entry.config), nil
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"k8s.io/client-go/rest"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+func TestMemberClientCache(t *testing.T) {
+	previous := karmadaRestConfig
+	karmadaRestConfig = &rest.Config{Host: "https://karmada-apiserver:5443"}
+	defer func() { karmadaRestConfig = previous }()
+
+	now := time.Now()
+	cache := newMemberClientCache(time.Minute)
+	cache.now = func() time.Time { return now }
+
+	alice := &clientcmdapi.AuthInfo{Token: "alice-token"}
+	get := func(clusterName string, authInfo *clientcmdapi.AuthInfo) *memberClientEntry {
+		t.Helper()
+		entry, err := cache.get(clusterName, authInfo)
+		if err != nil {
+			t.Fatalf("get(%s) error = %v", clusterName, err)
+		}
+		return entry
+	}
+
+	first := get("member1", alice)
+	if !strings.HasSuffix(first.config.Host, "/clusters/member1/proxy/") {
+		t.Errorf("host = %s, want the member1 proxy", first.config.Host)
+	}
+	if first.config.BearerToken != "alice-token" {
+		t.Errorf("bearer token = %q, want the caller's token", first.config.BearerToken)
+	}
+	if get("member1", &clientcmdapi.AuthInfo{Token: "alice-token"}) != first {
+		t.Errorf("same credentials did not reuse the cached client")
+	}
+	if get("member1", &clientcmdapi.AuthInfo{Token: "bob-token"}) == first {
+		t.Errorf("another token reused the cached client")
+	}
+	if get("member1", &clientcmdapi.AuthInfo{Token: "alice-token", Impersonate: "carol"}) == first {
+		t.Errorf("an impersonated user reused the cached client")
+	}
+	if get("member2", alice) == first {
+		t.Errorf("another cluster reused the cached client")
+	}
+
+	now = now.Add(2 * time.Minute)
+	if get("member1", alice) == first {
+		t.Errorf("an expired client was reused")
+	}
+	if len(cache.entries) != 1 {
+		t.Errorf("cache holds %d entries, want the expired ones removed", len(cache.entries))
+	}
+}
+
+func TestCredentialHash(t *testing.T) {
+	base := &clientcmdapi.AuthInfo{
+		Token:                "token",
+		Impersonate:          "alice",
+		ImpersonateGroups:    []string{"dev"},
+		ImpersonateUserExtra: map[string][]string{"scopes": {"a"}, "team": {"x"}},
+	}
+	same := &clientcmdapi.AuthInfo{
+		Token:                "token",
+		Impersonate:          "alice",
+		ImpersonateGroups:    []string{"dev"},
+		ImpersonateUserExtra: map[string][]string{"team": {"x"}, "scopes": {"a"}},
+	}
+	if credentialHash(base) != credentialHash(same) {
+		t.Errorf("equal credentials hash differently")
+	}
+	others := []*clientcmdapi.AuthInfo{
+		{Token: "token", Impersonate: "alice", ImpersonateGroups: []string{"ops"}, ImpersonateUserExtra: base.ImpersonateUserExtra},
+		{Token: "token", Impersonate: "alice", ImpersonateGroups: []string{"dev"}, ImpersonateUserExtra: map[string][]string{"scopes": {"b"}, "team": {"x"}}},
+		{Token: "token", Impersonate: "alicedev"},
+	}
+	for i, other := range others {
+		if credentialHash(base) == credentialHash(other) {
+			t.Errorf("credentials %d hash like the base credentials", i)
+		}
+	}
+}
@@ -19,6 +19,7 @@ package client
 import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 const (
@@ -42,12 +43,15 @@ const (
 	ImpersonateUserExtraHeader = "Impersonate-Extra-"
 	// MemberClusterHeaderName is the header name to identify member cluster name
 	MemberClusterHeaderName = "X-Member-ClusterName"
+	// FieldManager is the field manager the dashboard applies server-side patches as.
+	FieldManager = "karmada-dashboard"
 )
 
 // ResourceVerber is responsible for performing generic CRUD operations on all supported resources.
 type ResourceVerber interface {
-	Update(object *unstructured.Unstructured) error
+	Update(object *unstructured.Unstructured, dryRun []string) (*unstructured.Unstructured, error)
 	Get(kind string, namespace string, name string) (runtime.Object, error)
-	Delete(kind string, namespace string, name string, deleteNow bool) error
-	Create(object *unstructured.Unstructured) (*unstructured.Unstructured, error)
+	Delete(kind string, namespace string, name string, deleteNow bool, dryRun []string) error
+	Create(object *unstructured.Unstructured, dryRun []string) (*unstructured.Unstructured, error)
+	Patch(kind string, namespace string, name string, patchType types.PatchType, patch []byte, forceConflicts bool, dryRun []string) (*unstructured.Unstructured, error)
 }
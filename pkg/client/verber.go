@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"sync"
 
 	"github.com/gobuffalo/flect"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -36,14 +37,48 @@ import (
 )
 
 var (
-	kindToGroupVersionResource = map[string]schema.GroupVersionResource{}
+	// karmadaGroupVersionResources caches the kinds served by the karmada apiserver.
+	karmadaGroupVersionResources = newGroupVersionResourceCache()
+	// memberGroupVersionResources holds one *groupVersionResourceCache per member cluster, member clusters may
+	// serve different versions of a kind or CRDs the control plane does not know about.
+	memberGroupVersionResources sync.Map
 )
 
+// groupVersionResourceCache maps lower-cased kinds and "<resource>.<group>" names to the
+// GroupVersionResource discovered for them on one apiserver.
+type groupVersionResourceCache struct {
+	mu    sync.RWMutex
+	kinds map[string]schema.GroupVersionResource
+}
+
+func newGroupVersionResourceCache() *groupVersionResourceCache {
+	return &groupVersionResourceCache{kinds: map[string]schema.GroupVersionResource{}}
+}
+
+func (g *groupVersionResourceCache) get(kind string) (schema.GroupVersionResource, bool) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	gvr, exists := g.kinds[kind]
+	return gvr, exists
+}
+
+func (g *groupVersionResourceCache) set(kind string, gvr schema.GroupVersionResource) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.kinds[kind] = gvr
+}
+
+func memberGroupVersionResourceCache(clusterName string) *groupVersionResourceCache {
+	cache, _ := memberGroupVersionResources.LoadOrStore(clusterName, newGroupVersionResourceCache())
+	return cache.(*groupVersionResourceCache)
+}
+
 // resourceVerber is a struct responsible for doing common verb operations on resources, like
 // DELETE, PUT, UPDATE.
 type resourceVerber struct {
 	client    dynamic.Interface
 	discovery discovery.DiscoveryInterface
+	cache     *groupVersionResourceCache
 }
 
 func (v *resourceVerber) groupVersionResourceFromUnstructured(object *unstructured.Unstructured) schema.GroupVersionResource {
@@ -57,7 +92,7 @@ func (v *resourceVerber) groupVersionResourceFromUnstructured(object *unstructur
 }
 
 func (v *resourceVerber) groupVersionResourceFromKind(kind string) (schema.GroupVersionResource, error) {
-	if gvr, exists := kindToGroupVersionResource[kind]; exists {
+	if gvr, exists := v.cache.get(kind); exists {
 		klog.V(3).InfoS("GroupVersionResource cache hit", "kind", kind)
 		return gvr, nil
 	}
@@ -73,7 +108,7 @@ func (v *resourceVerber) groupVersionResourceFromKind(kind string) (schema.Group
 		return schema.GroupVersionResource{}, err
 	}
 
-	if gvr, exists := kindToGroupVersionResource[kind]; exists {
+	if gvr, exists := v.cache.get(kind); exists {
 		return gvr, nil
 	}
 
@@ -101,10 +136,10 @@ func (v *resourceVerber) buildGroupVersionResourceCache(resourceList []*metav1.A
 			}
 
 			// Mapping for core resources
-			kindToGroupVersionResource[strings.ToLower(apiResource.Kind)] = gvr
+			v.cache.set(strings.ToLower(apiResource.Kind), gvr)
 
 			// Mapping for CRD resources with custom kind
-			kindToGroupVersionResource[crdKind] = gvr
+			v.cache.set(crdKind, gvr)
 		}
 	}
 
@@ -112,7 +147,7 @@ func (v *resourceVerber) buildGroupVersionResourceCache(resourceList []*metav1.A
 }
 
 // Delete deletes the resource of the given kind in the given namespace with the given name.
-func (v *resourceVerber) Delete(kind string, namespace string, name string, deleteNow bool) error {
+func (v *resourceVerber) Delete(kind string, namespace string, name string, deleteNow bool, dryRun []string) error {
 	gvr, err := v.groupVersionResourceFromKind(kind)
 	if err != nil {
 		return err
@@ -122,6 +157,7 @@ func (v *resourceVerber) Delete(kind string, namespace string, name string, dele
 	defaultPropagationPolicy := metav1.DeletePropagationForeground
 	defaultDeleteOptions := metav1.DeleteOptions{
 		PropagationPolicy: &defaultPropagationPolicy,
+		DryRun:            dryRun,
 	}
 
 	if deleteNow {
@@ -133,12 +169,13 @@ func (v *resourceVerber) Delete(kind string, namespace string, name string, dele
 }
 
 // Update patches resource of the given kind in the given namespace with the given name.
-func (v *resourceVerber) Update(object *unstructured.Unstructured) error {
+func (v *resourceVerber) Update(object *unstructured.Unstructured, dryRun []string) (*unstructured.Unstructured, error) {
 	name := object.GetName()
 	namespace := object.GetNamespace()
 	gvr := v.groupVersionResourceFromUnstructured(object)
 
-	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+	var updated *unstructured.Unstructured
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
 		klog.V(2).InfoS("fetching latest resource version", "group", gvr.Group, "version", gvr.Version, "resource", gvr.Resource, "name", name, "namespace", namespace)
 		result, getErr := v.client.Resource(gvr).Namespace(namespace).Get(context.TODO(), name, metav1.GetOptions{})
 		if getErr != nil {
@@ -163,9 +200,11 @@ func (v *resourceVerber) Update(object *unstructured.Unstructured) error {
 		}
 
 		klog.V(3).InfoS("patching resource", "group", gvr.Group, "version", gvr.Version, "resource", gvr.Resource, "name", name, "namespace", namespace, "patch", string(patchBytes))
-		_, updateErr := v.client.Resource(gvr).Namespace(namespace).Patch(context.TODO(), name, k8stypes.MergePatchType, patchBytes, metav1.PatchOptions{})
+		var updateErr error
+		updated, updateErr = v.client.Resource(gvr).Namespace(namespace).Patch(context.TODO(), name, k8stypes.MergePatchType, patchBytes, metav1.PatchOptions{DryRun: dryRun})
 		return updateErr
 	})
+	return updated, err
 }
 
 // Get gets the resource of the given kind in the given namespace with the given name.
@@ -178,11 +217,33 @@ func (v *resourceVerber) Get(kind string, namespace string, name string) (runtim
 }
 
 // Create creates the resource of the given kind in the given namespace with the given name.
-func (v *resourceVerber) Create(object *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+func (v *resourceVerber) Create(object *unstructured.Unstructured, dryRun []string) (*unstructured.Unstructured, error) {
 	namespace := object.GetNamespace()
 	gvr := v.groupVersionResourceFromUnstructured(object)
 
-	return v.client.Resource(gvr).Namespace(namespace).Create(context.TODO(), object, metav1.CreateOptions{})
+	return v.client.Resource(gvr).Namespace(namespace).Create(context.TODO(), object, metav1.CreateOptions{DryRun: dryRun})
+}
+
+// Patch patches the resource of the given kind in the given namespace with the given name. Server-side apply
+// patches are sent as FieldManager, forceConflicts takes over fields owned by other managers.
+func (v *resourceVerber) Patch(kind string, namespace string, name string, patchType k8stypes.PatchType, patch []byte, forceConflicts bool, dryRun []string) (*unstructured.Unstructured, error) {
+	gvr, err := v.groupVersionResourceFromKind(kind)
+	if err != nil {
+		return nil, err
+	}
+
+	options := metav1.PatchOptions{DryRun: dryRun}
+	switch patchType {
+	case k8stypes.JSONPatchType, k8stypes.MergePatchType, k8stypes.StrategicMergePatchType:
+	case k8stypes.ApplyPatchType:
+		options.FieldManager = FieldManager
+		options.Force = &forceConflicts
+	default:
+		return nil, fmt.Errorf("unsupported patch type %q", patchType)
+	}
+
+	klog.V(3).InfoS("patching resource", "group", gvr.Group, "version", gvr.Version, "resource", gvr.Resource, "name", name, "namespace", namespace, "patchType", patchType)
+	return v.client.Resource(gvr).Namespace(namespace).Patch(context.TODO(), name, patchType, patch, options)
 }
 
 // VerberClient returns a resourceVerber client.
@@ -207,5 +268,31 @@ func VerberClient(request *http.Request) (ResourceVerber, error) {
 	return &resourceVerber{
 		client:    dynamicClient,
 		discovery: discoveryClient,
+		cache:     karmadaGroupVersionResources,
+	}, nil
+}
+
+// MemberVerberClient returns a resourceVerber client for the named member cluster, acting with the caller's
+// credentials through the karmada cluster proxy. Kinds are resolved by discovery against the member cluster.
+func MemberVerberClient(request *http.Request, memberClusterName string) (ResourceVerber, error) {
+	restConfig, err := RestConfigForMemberClusterFromRequest(request, memberClusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	dynamicClient, err := DynamicClientForMemberClusterFromRequest(request, memberClusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resourceVerber{
+		client:    dynamicClient,
+		discovery: discoveryClient,
+		cache:     memberGroupVersionResourceCache(memberClusterName),
 	}, nil
 }
@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+func fakeDiscovery(resources ...*metav1.APIResourceList) *fakediscovery.FakeDiscovery {
+	return &fakediscovery.FakeDiscovery{Fake: &clienttesting.Fake{Resources: resources}}
+}
+
+func TestMemberGroupVersionResourceCache(t *testing.T) {
+	member1 := &resourceVerber{
+		discovery: fakeDiscovery(&metav1.APIResourceList{
+			GroupVersion: "autoscaling/v2",
+			APIResources: []metav1.APIResource{
+				{Name: "horizontalpodautoscalers", Kind: "HorizontalPodAutoscaler"},
+				{Name: "horizontalpodautoscalers/status", Kind: "HorizontalPodAutoscaler"},
+			},
+		}),
+		cache: memberGroupVersionResourceCache("verber-test-member1"),
+	}
+	member2 := &resourceVerber{
+		discovery: fakeDiscovery(&metav1.APIResourceList{
+			GroupVersion: "autoscaling/v1",
+			APIResources: []metav1.APIResource{{Name: "horizontalpodautoscalers", Kind: "HorizontalPodAutoscaler"}},
+		}),
+		cache: memberGroupVersionResourceCache("verber-test-member2"),
+	}
+
+	for _, tc := range []struct {
+		verber *resourceVerber
+		kind   string
+		want   schema.GroupVersionResource
+	}{
+		{member1, "horizontalpodautoscaler", schema.GroupVersionResource{Group: "autoscaling", Version: "v2", Resource: "horizontalpodautoscalers"}},
+		{member2, "horizontalpodautoscaler", schema.GroupVersionResource{Group: "autoscaling", Version: "v1", Resource: "horizontalpodautoscalers"}},
+		{member1, "horizontalpodautoscalers.autoscaling", schema.GroupVersionResource{Group: "autoscaling", Version: "v2", Resource: "horizontalpodautoscalers"}},
+	} {
+		got, err := tc.verber.groupVersionResourceFromKind(tc.kind)
+		if err != nil {
+			t.Fatalf("groupVersionResourceFromKind(%s) error = %v", tc.kind, err)
+		}
+		if got != tc.want {
+			t.Errorf("groupVersionResourceFromKind(%s) = %v, want %v", tc.kind, got, tc.want)
+		}
+	}
+
+	if memberGroupVersionResourceCache("verber-test-member1") != member1.cache {
+		t.Errorf("member cluster cache was not reused")
+	}
+	if _, exists := karmadaGroupVersionResources.get("horizontalpodautoscaler"); exists {
+		t.Errorf("member discovery leaked into the karmada apiserver cache")
+	}
+	if _, err := member2.groupVersionResourceFromKind("deployment"); err == nil {
+		t.Errorf("expected an error for a kind the member cluster does not serve")
+	}
+}
+
+func TestResourceVerberPatch(t *testing.T) {
+	configMap := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]interface{}{"name": "settings", "namespace": "default"},
+		"data":       map[string]interface{}{"owner": "controller", "mode": "a"},
+	}}
+	cache := newGroupVersionResourceCache()
+	cache.set("configmap", schema.GroupVersionResource{Version: "v1", Resource: "configmaps"})
+	verber := &resourceVerber{
+		client: dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), configMap),
+		cache:  cache,
+	}
+
+	patched, err := verber.Patch("configmap", "default", "settings", k8stypes.MergePatchType, []byte(`{"data":{"mode":"b"}}`), false, nil)
+	if err != nil {
+		t.Fatalf("merge Patch() error = %v", err)
+	}
+	data, _, _ := unstructured.NestedStringMap(patched.Object, "data")
+	if data["mode"] != "b" || data["owner"] != "controller" {
+		t.Errorf("merge patched data = %v, want mode=b and owner kept", data)
+	}
+
+	patched, err = verber.Patch("configmap", "default", "settings", k8stypes.JSONPatchType, []byte(`[{"op":"remove","path":"/data/mode"}]`), false, nil)
+	if err != nil {
+		t.Fatalf("json Patch() error = %v", err)
+	}
+	data, _, _ = unstructured.NestedStringMap(patched.Object, "data")
+	if _, found := data["mode"]; found || data["owner"] != "controller" {
+		t.Errorf("json patched data = %v, want mode removed and owner kept", data)
+	}
+
+	if _, err = verber.Patch("configmap", "default", "settings", k8stypes.PatchType("application/yaml"), []byte(`{}`), false, nil); err == nil {
+		t.Errorf("expected an error for an unsupported patch type")
+	}
+}
@@ -29,6 +29,9 @@ const (
 	ResourceKindClusterPropagationPolicy = "clusterpropagationpolicy"
 	ResourceKindOverridePolicy           = "overridepolicy"
 	ResourceKindClusterOverridePolicy    = "clusteroverridepolicy"
+	ResourceKindResourceBinding          = "resourcebinding"
+	ResourceKindClusterResourceBinding   = "clusterresourcebinding"
+	ResourceKindWork                     = "work"
 	ResourceKindConfigMap                = "configmap"
 	ResourceKindDaemonSet                = "daemonset"
 	ResourceKindDeployment               = "deployment"
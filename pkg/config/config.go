@@ -29,6 +29,7 @@ import (
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
 )
 
 var dashboardConfig DashboardConfig
@@ -37,6 +38,11 @@ const (
 	configName      = "karmada-dashboard-configmap"
 	configNamespace = "karmada-system"
 	defaultEnvName  = "prod"
+
+	defaultKarmadaAgentImage    = "docker.io/karmada/karmada-agent"
+	defaultKarmadaAgentVersion  = "latest"
+	defaultKarmadaAgentReplicas = 2
+	defaultKarmadaAgentLogLevel = 4
 )
 
 var (
@@ -121,6 +127,54 @@ func GetDashboardConfig() DashboardConfig {
 	return config
 }
 
+// GetKarmadaAgentConfig returns the karmada-agent defaults for Pull mode joins, fields left empty in the
+// dashboard config fall back to the upstream karmada-agent image and flags.
+func GetKarmadaAgentConfig() KarmadaAgentConfig {
+	agent := KarmadaAgentConfig{}
+	if dashboardConfig.KarmadaAgent != nil {
+		agent = *dashboardConfig.KarmadaAgent
+	}
+	if agent.Image == "" {
+		agent.Image = defaultKarmadaAgentImage
+	}
+	if agent.Version == "" {
+		agent.Version = defaultKarmadaAgentVersion
+	}
+	if agent.Replicas <= 0 {
+		agent.Replicas = defaultKarmadaAgentReplicas
+	}
+	if agent.FeatureGates == nil {
+		agent.FeatureGates = map[string]bool{
+			"CustomizedClusterResourceModeling": true,
+			"MultiClusterService":               true,
+		}
+	}
+	if agent.LogLevel == nil {
+		agent.LogLevel = ptr.To(defaultKarmadaAgentLogLevel)
+	}
+	return agent
+}
+
+// GetOIDCProviders returns the configured OIDC identity providers (never nil).
+func GetOIDCProviders() []OIDCProviderConfig {
+	providers := make([]OIDCProviderConfig, len(dashboardConfig.OIDCProviders))
+	copy(providers, dashboardConfig.OIDCProviders)
+	return providers
+}
+
+// GetSecretKey returns the value of a Secret key referenced from the dashboard config.
+func GetSecretKey(ctx context.Context, k8sClient kubernetes.Interface, ref *SecretKeyRef) (string, error) {
+	secret, err := k8sClient.CoreV1().Secrets(configNamespace).Get(ctx, ref.Name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	value, ok := secret.Data[ref.Key]
+	if !ok {
+		return "", fmt.Errorf("secret %s/%s has no key %q", configNamespace, ref.Name, ref.Key)
+	}
+	return string(value), nil
+}
+
 // GetMetricsDashboards returns the persisted metrics dashboards (never nil).
 func GetMetricsDashboards() []MetricsDashboard {
 	if dashboardConfig.MetricsDashboards == nil {
@@ -73,11 +73,70 @@ type MetricsDashboard struct {
 	Panels    []MetricPanel `yaml:"panels" json:"panels"`
 }
 
+// KarmadaAgentResources represents the resource requests and limits of the karmada-agent container,
+// quantities are written the Kubernetes way, e.g. "100m" or "128Mi".
+type KarmadaAgentResources struct {
+	Requests map[string]string `yaml:"requests,omitempty" json:"requests,omitempty"`
+	Limits   map[string]string `yaml:"limits,omitempty" json:"limits,omitempty"`
+}
+
+// KarmadaAgentConfig represents the defaults of the karmada-agent deployed when a cluster joins in Pull mode.
+type KarmadaAgentConfig struct {
+	// Image is the image repository of karmada-agent, e.g. registry.local/karmada/karmada-agent.
+	Image string `yaml:"image" json:"image"`
+	// Version is the image tag of karmada-agent.
+	Version            string                `yaml:"version" json:"version"`
+	ImagePullSecrets   []string              `yaml:"image_pull_secrets,omitempty" json:"image_pull_secrets,omitempty"`
+	Replicas           int32                 `yaml:"replicas" json:"replicas"`
+	Resources          KarmadaAgentResources `yaml:"resources,omitempty" json:"resources,omitempty"`
+	FeatureGates       map[string]bool       `yaml:"feature_gates,omitempty" json:"feature_gates,omitempty"`
+	ProxyServerAddress string                `yaml:"proxy_server_address,omitempty" json:"proxy_server_address,omitempty"`
+	HTTPProxy          string                `yaml:"http_proxy,omitempty" json:"http_proxy,omitempty"`
+	HTTPSProxy         string                `yaml:"https_proxy,omitempty" json:"https_proxy,omitempty"`
+	NoProxy            string                `yaml:"no_proxy,omitempty" json:"no_proxy,omitempty"`
+	// LogLevel is the klog verbosity of karmada-agent, 0 is honoured and only an unset value defaults to 4.
+	LogLevel *int `yaml:"log_level,omitempty" json:"log_level,omitempty"`
+}
+
+// OIDCImpersonationConfig represents how ID token claims map to the Kubernetes user the dashboard impersonates.
+// Prefixes default to "<provider id>:", "-" disables one.
+type OIDCImpersonationConfig struct {
+	UsernameClaim  string `yaml:"username_claim,omitempty" json:"username_claim,omitempty"`
+	UsernamePrefix string `yaml:"username_prefix,omitempty" json:"username_prefix,omitempty"`
+	GroupsClaim    string `yaml:"groups_claim,omitempty" json:"groups_claim,omitempty"`
+	GroupsPrefix   string `yaml:"groups_prefix,omitempty" json:"groups_prefix,omitempty"`
+}
+
+// SecretKeyRef references a key of a Secret in the namespace of the dashboard ConfigMap.
+type SecretKeyRef struct {
+	Name string `yaml:"name" json:"name"`
+	Key  string `yaml:"key" json:"key"`
+}
+
+// OIDCProviderConfig represents an OpenID Connect identity provider users can log in with.
+type OIDCProviderConfig struct {
+	// ID identifies the provider, a lowercase RFC 1123 label such as "corp".
+	ID          string `yaml:"id" json:"id"`
+	DisplayName string `yaml:"display_name" json:"display_name"`
+	IssuerURL   string `yaml:"issuer_url" json:"issuer_url"`
+	ClientID    string `yaml:"client_id" json:"client_id"`
+	// ClientSecretRef names the Secret key holding the client secret, which is kept out of the ConfigMap.
+	ClientSecretRef       *SecretKeyRef `yaml:"client_secret_ref,omitempty" json:"client_secret_ref,omitempty"`
+	RedirectURL           string        `yaml:"redirect_url" json:"redirect_url"`
+	Scopes                []string      `yaml:"scopes,omitempty" json:"scopes,omitempty"`
+	PostLogoutRedirectURL string        `yaml:"post_logout_redirect_url,omitempty" json:"post_logout_redirect_url,omitempty"`
+	// Impersonation, if set, makes the dashboard call Karmada with its own credentials impersonating the
+	// provider's users, for control planes that do not trust the provider.
+	Impersonation *OIDCImpersonationConfig `yaml:"impersonation,omitempty" json:"impersonation,omitempty"`
+}
+
 // DashboardConfig represents the configuration structure for the Karmada dashboard.
 type DashboardConfig struct {
-	DockerRegistries  []DockerRegistry   `yaml:"docker_registries" json:"docker_registries"`
-	ChartRegistries   []ChartRegistry    `yaml:"chart_registries" json:"chart_registries"`
-	MenuConfigs       []MenuConfig       `yaml:"menu_configs" json:"menu_configs"`
-	PathPrefix        string             `yaml:"path_prefix" json:"path_prefix"`
-	MetricsDashboards []MetricsDashboard `yaml:"metrics_dashboards,omitempty" json:"metrics_dashboards,omitempty"`
+	DockerRegistries  []DockerRegistry     `yaml:"docker_registries" json:"docker_registries"`
+	ChartRegistries   []ChartRegistry      `yaml:"chart_registries" json:"chart_registries"`
+	MenuConfigs       []MenuConfig         `yaml:"menu_configs" json:"menu_configs"`
+	PathPrefix        string               `yaml:"path_prefix" json:"path_prefix"`
+	MetricsDashboards []MetricsDashboard   `yaml:"metrics_dashboards,omitempty" json:"metrics_dashboards,omitempty"`
+	KarmadaAgent      *KarmadaAgentConfig  `yaml:"karmada_agent,omitempty" json:"karmada_agent,omitempty"`
+	OIDCProviders     []OIDCProviderConfig `yaml:"oidc_providers,omitempty" json:"oidc_providers,omitempty"`
 }
@@ -28,4 +28,10 @@ const (
 	FirstSeenProperty         = "firstSeen"
 	LastSeenProperty          = "lastSeen"
 	ReasonProperty            = "reason"
+	ClusterProperty           = "cluster"
+	ScheduledProperty         = "scheduled"
+	FullyAppliedProperty      = "fullyApplied"
+	AppliedProperty           = "applied"
+	ResourceKindProperty      = "resourceKind"
+	ResourceNameProperty      = "resourceName"
 )
@@ -90,6 +90,27 @@ func (t StdComparableTime) Contains(otherV ComparableValue) bool {
 	return t.Compare(otherV) == 0
 }
 
+// StdComparableStringSlice is a wrapper for a list of strings that implements ComparableValueInterface.
+// It contains a string equal to one of its elements, which makes it usable to filter on set membership.
+type StdComparableStringSlice []string
+
+// Compare compares two string slices by their comma joined values.
+func (s StdComparableStringSlice) Compare(otherV ComparableValue) int {
+	other := otherV.(StdComparableStringSlice)
+	return strings.Compare(strings.Join(s, ","), strings.Join(other, ","))
+}
+
+// Contains checks if other is one of the elements of self.
+func (s StdComparableStringSlice) Contains(otherV ComparableValue) bool {
+	other := otherV.(StdComparableString)
+	for _, v := range s {
+		if v == string(other) {
+			return true
+		}
+	}
+	return false
+}
+
 // Int comparison functions. Similar to strings.Compare.
 func intsCompare(a, b int) int {
 	if a > b {
@@ -162,3 +162,33 @@ func TestStdComparableRFC3339Timestamp(t *testing.T) {
 		}
 	}
 }
+
+func TestStdComparableStringSliceContains(t *testing.T) {
+	cases := []struct {
+		a        StdComparableStringSlice
+		b        StdComparableString
+		expected bool
+	}{
+		{
+			StdComparableStringSlice{"member1", "member2"},
+			StdComparableString("member2"),
+			true,
+		},
+		{
+			StdComparableStringSlice{"member10"},
+			StdComparableString("member1"),
+			false,
+		},
+		{
+			nil,
+			StdComparableString("member1"),
+			false,
+		},
+	}
+	for _, c := range cases {
+		actual := c.a.Contains(c.b)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("Contains(%+v) == %+v, expected %+v", c.b, actual, c.expected)
+		}
+	}
+}
@@ -28,8 +28,12 @@ import (
 const (
 	// ResourceBindingByOwnerUID indexes ResourceBindings by ownerReferences UID.
 	ResourceBindingByOwnerUID = "byOwnerUID"
+	// ClusterResourceBindingByOwnerUID indexes ClusterResourceBindings by ownerReferences UID.
+	ClusterResourceBindingByOwnerUID = "byOwnerUID"
 	// WorkByRBName indexes Works by annotation resourcebinding.karmada.io/name.
 	WorkByRBName = "byRBName"
+	// WorkByCRBName indexes Works by annotation clusterresourcebinding.karmada.io/name.
+	WorkByCRBName = "byCRBName"
 )
 
 var factory karmadainformers.SharedInformerFactory
@@ -52,11 +56,32 @@ func Init(karmadaClient karmadaclientset.Interface, stopper <-chan struct{}) {
 		klog.Warningf("Failed to add ResourceBinding indexer: %v", err)
 	}
 
+	crbInformer := factory.Work().V1alpha2().ClusterResourceBindings().Informer()
+	if err := crbInformer.AddIndexers(cache.Indexers{
+		ClusterResourceBindingByOwnerUID: func(obj interface{}) ([]string, error) {
+			crb := obj.(*workv1alpha2.ClusterResourceBinding)
+			var keys []string
+			for _, ref := range crb.OwnerReferences {
+				keys = append(keys, string(ref.UID))
+			}
+			return keys, nil
+		},
+	}); err != nil {
+		klog.Warningf("Failed to add ClusterResourceBinding indexer: %v", err)
+	}
+
 	workInformer := factory.Work().V1alpha1().Works().Informer()
 	if err := workInformer.AddIndexers(cache.Indexers{
 		WorkByRBName: func(obj interface{}) ([]string, error) {
 			work := obj.(*workv1alpha1.Work)
-			if name := work.Annotations["resourcebinding.karmada.io/name"]; name != "" {
+			if name := work.Annotations[workv1alpha2.ResourceBindingNameAnnotationKey]; name != "" {
+				return []string{name}, nil
+			}
+			return nil, nil
+		},
+		WorkByCRBName: func(obj interface{}) ([]string, error) {
+			work := obj.(*workv1alpha1.Work)
+			if name := work.Annotations[workv1alpha2.ClusterResourceBindingAnnotationKey]; name != "" {
 				return []string{name}, nil
 			}
 			return nil, nil
@@ -78,12 +103,32 @@ func sharedInformerFactory() karmadainformers.SharedInformerFactory {
 	return factory
 }
 
+// ResourceBindingInformer returns the ResourceBinding shared informer.
+func ResourceBindingInformer() cache.SharedIndexInformer {
+	return sharedInformerFactory().Work().V1alpha2().ResourceBindings().Informer()
+}
+
 // ResourceBindingIndexer returns the ResourceBinding indexer.
 func ResourceBindingIndexer() cache.Indexer {
-	return sharedInformerFactory().Work().V1alpha2().ResourceBindings().Informer().GetIndexer()
+	return ResourceBindingInformer().GetIndexer()
+}
+
+// ClusterResourceBindingInformer returns the ClusterResourceBinding shared informer.
+func ClusterResourceBindingInformer() cache.SharedIndexInformer {
+	return sharedInformerFactory().Work().V1alpha2().ClusterResourceBindings().Informer()
+}
+
+// ClusterResourceBindingIndexer returns the ClusterResourceBinding indexer.
+func ClusterResourceBindingIndexer() cache.Indexer {
+	return ClusterResourceBindingInformer().GetIndexer()
+}
+
+// WorkInformer returns the Work shared informer.
+func WorkInformer() cache.SharedIndexInformer {
+	return sharedInformerFactory().Work().V1alpha1().Works().Informer()
 }
 
 // WorkIndexer returns the Work indexer.
 func WorkIndexer() cache.Indexer {
-	return sharedInformerFactory().Work().V1alpha1().Works().Informer().GetIndexer()
+	return WorkInformer().GetIndexer()
 }
@@ -0,0 +1,204 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oidc
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const (
+	defaultUsernameClaim = "email"
+	defaultGroupsClaim   = "groups"
+
+	// NoPrefix disables a prefix, like "-" does for the apiserver's --oidc-username-prefix.
+	NoPrefix = "-"
+	// reservedPrefix starts the users and groups of Kubernetes itself, which are never impersonated.
+	reservedPrefix = "system:"
+)
+
+// ImpersonationConfig maps ID token claims to the Kubernetes user the dashboard impersonates, for control
+// planes whose apiserver does not trust the identity provider. Prefixes keep OIDC users apart from others.
+type ImpersonationConfig struct {
+	// UsernameClaim is the claim holding the username, "email" if empty.
+	UsernameClaim string
+	// UsernamePrefix is prepended to usernames, "<provider ID>:" if empty and nothing if NoPrefix.
+	UsernamePrefix string
+	// GroupsClaim is the claim holding the groups, a string or a list of strings, "groups" if empty.
+	GroupsClaim string
+	// GroupsPrefix is prepended to groups, "<provider ID>:" if empty and nothing if NoPrefix.
+	GroupsPrefix string
+}
+
+// withDefaults returns a copy of c with the claims and prefixes of the provider with providerID filled in.
+func (c *ImpersonationConfig) withDefaults(providerID string) *ImpersonationConfig {
+	if c == nil {
+		return nil
+	}
+	resolved := *c
+	if resolved.UsernameClaim == "" {
+		resolved.UsernameClaim = defaultUsernameClaim
+	}
+	if resolved.GroupsClaim == "" {
+		resolved.GroupsClaim = defaultGroupsClaim
+	}
+	resolved.UsernamePrefix = resolvePrefix(c.UsernamePrefix, providerID)
+	resolved.GroupsPrefix = resolvePrefix(c.GroupsPrefix, providerID)
+	return &resolved
+}
+
+func resolvePrefix(prefix, providerID string) string {
+	switch prefix {
+	case "":
+		return providerID + ":"
+	case NoPrefix:
+		return ""
+	default:
+		return prefix
+	}
+}
+
+// overlaps reports whether a user or group of one of two providers could be taken for one of the other,
+// which is the case when a username or groups prefix starts with the other's.
+func (c *ImpersonationConfig) overlaps(other *ImpersonationConfig) bool {
+	related := func(a, b string) bool {
+		return strings.HasPrefix(a, b) || strings.HasPrefix(b, a)
+	}
+	return related(c.UsernamePrefix, other.UsernamePrefix) || related(c.GroupsPrefix, other.GroupsPrefix)
+}
+
+// Identity is the Kubernetes user an ID token maps to.
+type Identity struct {
+	Username string
+	Groups   []string
+}
+
+// Identity verifies rawIDToken and maps its claims to the user to impersonate. It returns nil if the
+// provider is not in impersonation mode.
+func (p *Provider) Identity(ctx context.Context, rawIDToken string) (*Identity, error) {
+	if p.impersonation == nil {
+		return nil, nil
+	}
+	idToken, err := p.VerifyIDToken(ctx, rawIDToken)
+	if err != nil {
+		return nil, err
+	}
+	var claims map[string]interface{}
+	if err = idToken.Claims(&claims); err != nil {
+		return nil, fmt.Errorf("failed to parse ID token claims: %w", err)
+	}
+	return p.impersonation.identity(claims)
+}
+
+// Identity returns the user to impersonate for rawIDToken if it was issued by a provider in impersonation
+// mode, nil for any other bearer token, such as a service account token, which is passed on as is.
+func (r *Registry) Identity(ctx context.Context, rawIDToken string) (*Identity, error) {
+	issuer := unverifiedIssuer(rawIDToken)
+	if issuer == "" {
+		return nil, nil
+	}
+	r.mu.Lock()
+	var candidates []*Provider
+	for _, id := range r.order {
+		if p := r.providers[id].provider; p != nil && p.impersonation != nil && p.issuer == issuer {
+			candidates = append(candidates, p)
+		}
+	}
+	r.mu.Unlock()
+
+	// Providers sharing an issuer differ by client ID, the verifier checks the audience.
+	var err error
+	for _, p := range candidates {
+		var identity *Identity
+		if identity, err = p.Identity(ctx, rawIDToken); err == nil {
+			return identity, nil
+		}
+	}
+	return nil, err
+}
+
+// identity maps claims to the user to impersonate, c must have its defaults filled in by withDefaults.
+func (c *ImpersonationConfig) identity(claims map[string]interface{}) (*Identity, error) {
+	usernameClaim := c.UsernameClaim
+	groupsClaim := c.GroupsClaim
+
+	username, _ := claims[usernameClaim].(string)
+	if username == "" {
+		return nil, fmt.Errorf("ID token has no %q claim", usernameClaim)
+	}
+	// Like the apiserver, only trust an email the identity provider does not say is unverified.
+	if usernameClaim == "email" {
+		if verified, found := claims["email_verified"]; found {
+			if ok, _ := verified.(bool); !ok {
+				return nil, fmt.Errorf("email %q is not verified", username)
+			}
+		}
+	}
+
+	var groups []string
+	switch value := claims[groupsClaim].(type) {
+	case nil:
+	case string:
+		groups = []string{value}
+	case []interface{}:
+		for _, group := range value {
+			name, ok := group.(string)
+			if !ok {
+				return nil, fmt.Errorf("claim %q is not a list of strings", groupsClaim)
+			}
+			groups = append(groups, name)
+		}
+	default:
+		return nil, fmt.Errorf("claim %q is neither a string nor a list of strings", groupsClaim)
+	}
+
+	identity := &Identity{Username: c.UsernamePrefix + username}
+	if strings.HasPrefix(identity.Username, reservedPrefix) {
+		return nil, fmt.Errorf("username %q is reserved for Kubernetes", identity.Username)
+	}
+	for _, group := range groups {
+		group = c.GroupsPrefix + group
+		if strings.HasPrefix(group, reservedPrefix) {
+			return nil, fmt.Errorf("group %q is reserved for Kubernetes", group)
+		}
+		identity.Groups = append(identity.Groups, group)
+	}
+	return identity, nil
+}
+
+// unverifiedIssuer returns the iss claim of a JWT without verifying it, empty if token is not a JWT.
+// It only picks the providers to verify the token with.
+func unverifiedIssuer(token string) string {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return ""
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return ""
+	}
+	var claims struct {
+		Issuer string `json:"iss"`
+	}
+	if err = json.Unmarshal(payload, &claims); err != nil {
+		return ""
+	}
+	return claims.Issuer
+}
@@ -23,7 +23,9 @@ import (
 	"crypto/sha256"
 	"encoding/base64"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"net/url"
 	"strconv"
 	"strings"
 	"sync"
@@ -33,23 +35,47 @@ import (
 	"golang.org/x/oauth2"
 )
 
+// stateSeparator separates the provider ID from the rest of a login state.
+const stateSeparator = ":"
+
+// discoveryTimeout bounds the OIDC discovery of a provider.
+var discoveryTimeout = 10 * time.Second
+
 // Config holds OIDC provider configuration
 type Config struct {
+	// ID identifies the provider in login states and sessions.
+	ID string
+	// DisplayName is shown to users choosing a provider.
+	DisplayName  string
 	IssuerURL    string
 	ClientID     string
 	ClientSecret string
 	RedirectURL  string
 	Scopes       []string
+	// PostLogoutRedirectURL is where the identity provider sends the browser after logout.
+	PostLogoutRedirectURL string
+	// Impersonation, if set, makes the dashboard call Karmada with its own credentials on behalf of users
+	// of this provider, impersonating the user their ID token maps to.
+	Impersonation *ImpersonationConfig
 }
 
 // Provider wraps OIDC provider and OAuth2 config
 type Provider struct {
+	id           string
+	displayName  string
+	issuer       string
 	oauth2Config *oauth2.Config
 	oidcProvider *oidc.Provider
 	verifier     *oidc.IDTokenVerifier
 	stateKey     []byte
+	pkceKey      []byte
 	states       sync.Map // stores state -> expiry time for CSRF protection
 	usedStates   sync.Map // stores used state -> used time for replay protection
+
+	// endSessionEndpoint is the RP-initiated logout endpoint, empty if discovery does not expose one.
+	endSessionEndpoint    string
+	postLogoutRedirectURL string
+	impersonation         *ImpersonationConfig
 }
 
 // stateEntry stores state validation data
@@ -69,12 +95,22 @@ func NewProvider(ctx context.Context, cfg *Config) (*Provider, error) {
 		return nil, fmt.Errorf("redirect URL is required")
 	}
 
-	// Perform OIDC discovery
-	provider, err := oidc.NewProvider(ctx, cfg.IssuerURL)
+	// Perform OIDC discovery. ctx lives as long as the provider, so discovery gets its own deadline and
+	// an issuer that does not answer cannot block startup or a registry sync.
+	discoveryCtx, cancel := context.WithTimeout(ctx, discoveryTimeout)
+	defer cancel()
+	provider, err := oidc.NewProvider(discoveryCtx, cfg.IssuerURL)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create OIDC provider: %w", err)
 	}
 
+	var discovered struct {
+		EndSessionEndpoint string `json:"end_session_endpoint"`
+	}
+	if err := provider.Claims(&discovered); err != nil {
+		return nil, fmt.Errorf("failed to read OIDC discovery document: %w", err)
+	}
+
 	// Configure OAuth2
 	oauth2Config := &oauth2.Config{
 		ClientID:     cfg.ClientID,
@@ -89,11 +125,24 @@ func NewProvider(ctx context.Context, cfg *Config) (*Provider, error) {
 		ClientID: cfg.ClientID,
 	})
 
+	pkceKey, err := derivePKCEKey(cfg)
+	if err != nil {
+		return nil, err
+	}
+
 	p := &Provider{
+		id:           cfg.ID,
+		displayName:  cfg.DisplayName,
+		issuer:       cfg.IssuerURL,
 		oauth2Config: oauth2Config,
 		oidcProvider: provider,
 		verifier:     verifier,
 		stateKey:     deriveStateKey(cfg),
+		pkceKey:      pkceKey,
+
+		endSessionEndpoint:    discovered.EndSessionEndpoint,
+		postLogoutRedirectURL: cfg.PostLogoutRedirectURL,
+		impersonation:         cfg.Impersonation.withDefaults(cfg.ID),
 	}
 
 	// Start background cleanup of expired states
@@ -102,12 +151,16 @@ func NewProvider(ctx context.Context, cfg *Config) (*Provider, error) {
 	return p, nil
 }
 
-// GenerateAuthURL generates authorization URL with a random state parameter
+// GenerateAuthURL generates authorization URL with a random state parameter and a PKCE (S256) challenge.
+// The state is prefixed with the provider ID, so the callback can tell which provider a login belongs to.
 func (p *Provider) GenerateAuthURL() (authURL string, state string, err error) {
 	state, err = p.generateState()
 	if err != nil {
 		return "", "", err
 	}
+	if p.id != "" {
+		state = p.id + stateSeparator + state
+	}
 
 	// Store state with timestamp
 	p.states.Store(state, stateEntry{
@@ -115,7 +168,7 @@ func (p *Provider) GenerateAuthURL() (authURL string, state string, err error) {
 	})
 
 	// Generate authorization URL
-	authURL = p.oauth2Config.AuthCodeURL(state, oauth2.AccessTypeOffline)
+	authURL = p.oauth2Config.AuthCodeURL(state, oauth2.AccessTypeOffline, oauth2.S256ChallengeOption(p.codeVerifier(state)))
 
 	return authURL, state, nil
 }
@@ -140,7 +193,7 @@ func (p *Provider) ValidateState(state string) error {
 	}
 
 	// Fallback to stateless signature validation to support multi-instance / restart scenarios.
-	if !p.validateSignedState(state, 10*time.Minute) {
+	if !p.validateSignedState(strings.TrimPrefix(state, p.id+stateSeparator), 10*time.Minute) {
 		return fmt.Errorf("invalid or expired state parameter")
 	}
 	p.usedStates.Store(state, time.Now())
@@ -157,6 +210,29 @@ func deriveStateKey(cfg *Config) []byte {
 	return sum[:]
 }
 
+// derivePKCEKey returns the key PKCE code verifiers are derived from. Confidential clients derive it from
+// the client secret so any instance can finish a login; public clients have no secret an attacker lacks,
+// so they get a random key and must finish the login on the instance that started it.
+func derivePKCEKey(cfg *Config) ([]byte, error) {
+	if cfg.ClientSecret != "" {
+		sum := sha256.Sum256([]byte("pkce|" + cfg.ClientSecret))
+		return sum[:], nil
+	}
+	key := make([]byte, sha256.Size)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("failed to generate PKCE key: %w", err)
+	}
+	return key, nil
+}
+
+// codeVerifier derives the PKCE code verifier of a login from its state, so the verifier does not
+// need to be stored between the authorization request and the code exchange.
+func (p *Provider) codeVerifier(state string) string {
+	mac := hmac.New(sha256.New, p.pkceKey)
+	_, _ = mac.Write([]byte(state))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
 func (p *Provider) generateState() (string, error) {
 	nonce := make([]byte, 32)
 	if _, err := rand.Read(nonce); err != nil {
@@ -215,13 +291,14 @@ func (p *Provider) validateSignedState(state string, maxAge time.Duration) bool
 	return hmac.Equal(expectedSig, providedSig)
 }
 
-// ExchangeToken exchanges authorization code for tokens
-func (p *Provider) ExchangeToken(ctx context.Context, code string) (*oauth2.Token, error) {
+// ExchangeToken exchanges authorization code for tokens, proving with the PKCE code verifier of state
+// that this server started the login
+func (p *Provider) ExchangeToken(ctx context.Context, code, state string) (*oauth2.Token, error) {
 	if code == "" {
 		return nil, fmt.Errorf("authorization code is empty")
 	}
 
-	token, err := p.oauth2Config.Exchange(ctx, code)
+	token, err := p.oauth2Config.Exchange(ctx, code, oauth2.VerifierOption(p.codeVerifier(state)))
 	if err != nil {
 		return nil, fmt.Errorf("failed to exchange token: %w", err)
 	}
@@ -243,6 +320,93 @@ func (p *Provider) VerifyIDToken(ctx context.Context, rawIDToken string) (*oidc.
 	return idToken, nil
 }
 
+// ErrNoIDTokenOnRefresh is returned when the identity provider answers a refresh without a new id_token.
+var ErrNoIDTokenOnRefresh = errors.New("identity provider returned no id_token on refresh")
+
+// Tokens are the verified tokens of a code exchange or a refresh.
+type Tokens struct {
+	// ProviderID is the ID of the provider that issued the tokens.
+	ProviderID   string
+	IDToken      string
+	RefreshToken string
+	// Expiry is when the ID token expires.
+	Expiry time.Time
+}
+
+// VerifyTokens extracts the ID token of a token response and verifies it.
+func (p *Provider) VerifyTokens(ctx context.Context, token *oauth2.Token) (*Tokens, error) {
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok {
+		return nil, fmt.Errorf("no id_token in token response")
+	}
+	idToken, err := p.VerifyIDToken(ctx, rawIDToken)
+	if err != nil {
+		return nil, err
+	}
+	return &Tokens{
+		ProviderID:   p.ID(),
+		IDToken:      rawIDToken,
+		RefreshToken: token.RefreshToken,
+		Expiry:       idToken.Expiry,
+	}, nil
+}
+
+// Refresh redeems a refresh token for a new, verified ID token. The refresh token is kept
+// when the identity provider does not rotate it.
+//
+// Sessions call Karmada with the ID token, so they can only be extended by identity providers that
+// return a new id_token on refresh. OIDC Core 12.2 makes that optional; without one ErrNoIDTokenOnRefresh
+// is returned and the user has to log in again once the ID token expires.
+func (p *Provider) Refresh(ctx context.Context, refreshToken string) (*Tokens, error) {
+	token, err := p.oauth2Config.TokenSource(ctx, &oauth2.Token{RefreshToken: refreshToken}).Token()
+	if err != nil {
+		return nil, fmt.Errorf("failed to refresh token: %w", err)
+	}
+	if _, ok := token.Extra("id_token").(string); !ok {
+		return nil, fmt.Errorf("OIDC provider %q: %w", p.ID(), ErrNoIDTokenOnRefresh)
+	}
+	tokens, err := p.VerifyTokens(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if tokens.RefreshToken == "" {
+		tokens.RefreshToken = refreshToken
+	}
+	return tokens, nil
+}
+
+// ID returns the ID of the provider.
+func (p *Provider) ID() string {
+	return p.id
+}
+
+// DisplayName returns the name users choose the provider by.
+func (p *Provider) DisplayName() string {
+	return p.displayName
+}
+
+// EndSessionURL returns the identity provider's logout URL for the session of idTokenHint,
+// empty if discovery does not expose an end_session_endpoint.
+func (p *Provider) EndSessionURL(idTokenHint string) string {
+	if p.endSessionEndpoint == "" {
+		return ""
+	}
+	endSessionURL, err := url.Parse(p.endSessionEndpoint)
+	if err != nil {
+		return ""
+	}
+	query := endSessionURL.Query()
+	query.Set("client_id", p.oauth2Config.ClientID)
+	if idTokenHint != "" {
+		query.Set("id_token_hint", idTokenHint)
+	}
+	if p.postLogoutRedirectURL != "" {
+		query.Set("post_logout_redirect_uri", p.postLogoutRedirectURL)
+	}
+	endSessionURL.RawQuery = query.Encode()
+	return endSessionURL.String()
+}
+
 // cleanupExpiredStates periodically removes expired state entries
 func (p *Provider) cleanupExpiredStates(ctx context.Context) {
 	ticker := time.NewTicker(5 * time.Minute)
@@ -17,12 +17,18 @@ limitations under the License.
 package oidc
 
 import (
+	"context"
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
 	"strconv"
 	"testing"
 	"time"
+
+	"golang.org/x/oauth2"
 )
 
 func TestValidateState_InMemoryOneTime(t *testing.T) {
@@ -71,3 +77,44 @@ func TestValidateState_StatelessExpired(t *testing.T) {
 		t.Fatalf("expired state should fail")
 	}
 }
+
+func TestRefresh_NoIDToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"access","token_type":"Bearer","refresh_token":"refresh-2","expires_in":3600}`))
+	}))
+	defer server.Close()
+	p := &Provider{
+		id: "corp",
+		oauth2Config: &oauth2.Config{
+			ClientID: "karmada-dashboard",
+			Endpoint: oauth2.Endpoint{TokenURL: server.URL},
+		},
+	}
+
+	if _, err := p.Refresh(context.TODO(), "refresh-1"); !errors.Is(err, ErrNoIDTokenOnRefresh) {
+		t.Fatalf("Refresh() error = %v, want %v", err, ErrNoIDTokenOnRefresh)
+	}
+}
+
+func TestNewProvider_DiscoveryTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+	defer func(timeout time.Duration) { discoveryTimeout = timeout }(discoveryTimeout)
+	discoveryTimeout = 100 * time.Millisecond
+
+	start := time.Now()
+	_, err := NewProvider(context.Background(), &Config{
+		IssuerURL:   server.URL,
+		ClientID:    "karmada-dashboard",
+		RedirectURL: "https://dashboard.example.com/login/callback",
+	})
+	if err == nil {
+		t.Fatalf("NewProvider() succeeded against an issuer that does not answer")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("NewProvider() returned after %v, want it bounded by the discovery timeout", elapsed)
+	}
+}
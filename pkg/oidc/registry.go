@@ -0,0 +1,211 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oidc
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// retryInterval is how long a provider whose discovery failed is left out before it is retried.
+const retryInterval = time.Minute
+
+var providerIDPattern = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+
+// ProviderInfo is what users see of a provider when choosing one.
+type ProviderInfo struct {
+	ID          string `json:"id"`
+	DisplayName string `json:"displayName"`
+}
+
+// registeredProvider is a configured provider, with a nil provider if its discovery failed.
+type registeredProvider struct {
+	config   Config
+	provider *Provider
+	cancel   context.CancelFunc
+	failedAt time.Time
+}
+
+// Registry holds the identity providers users can log in with, keyed by ID.
+type Registry struct {
+	ctx         context.Context
+	newProvider func(ctx context.Context, cfg *Config) (*Provider, error)
+	now         func() time.Time
+
+	// syncMu serializes Sync, mu guards order and providers and is never held during discovery.
+	syncMu    sync.Mutex
+	mu        sync.Mutex
+	order     []string
+	providers map[string]*registeredProvider
+}
+
+// NewRegistry returns an empty registry, providers are stopped when ctx is done.
+func NewRegistry(ctx context.Context) *Registry {
+	return &Registry{
+		ctx:         ctx,
+		newProvider: NewProvider,
+		now:         time.Now,
+		providers:   map[string]*registeredProvider{},
+	}
+}
+
+// Sync makes the registry serve exactly configs, in order. Providers whose configuration did not change
+// are kept, new or changed ones run discovery. A provider whose discovery fails is left out and retried
+// on a later Sync, as is one that impersonates users with prefixes overlapping those of an earlier
+// provider; the returned errors describe the providers left out. Discovery runs without holding
+// the lock Get and List take, the new providers are swapped in once it is done.
+func (r *Registry) Sync(configs []Config) []error {
+	r.syncMu.Lock()
+	defer r.syncMu.Unlock()
+
+	r.mu.Lock()
+	current := r.providers
+	r.mu.Unlock()
+
+	var errs []error
+	var stale []context.CancelFunc
+	order := make([]string, 0, len(configs))
+	providers := make(map[string]*registeredProvider, len(configs))
+	for i := range configs {
+		cfg := configs[i]
+		if !providerIDPattern.MatchString(cfg.ID) {
+			errs = append(errs, fmt.Errorf("invalid OIDC provider ID %q, it must be a lowercase RFC 1123 label", cfg.ID))
+			continue
+		}
+		if _, ok := providers[cfg.ID]; ok {
+			errs = append(errs, fmt.Errorf("duplicate OIDC provider ID %q", cfg.ID))
+			continue
+		}
+		if conflict := impersonationConflict(order, providers, &cfg); conflict != "" {
+			errs = append(errs, fmt.Errorf("OIDC provider %q impersonates users with the prefixes of provider %q", cfg.ID, conflict))
+			continue
+		}
+		order = append(order, cfg.ID)
+
+		existing, ok := current[cfg.ID]
+		if ok && reflect.DeepEqual(existing.config, cfg) {
+			if existing.provider != nil || r.now().Sub(existing.failedAt) < retryInterval {
+				providers[cfg.ID] = existing
+				continue
+			}
+		}
+		if ok && existing.cancel != nil {
+			stale = append(stale, existing.cancel)
+		}
+		ctx, cancel := context.WithCancel(r.ctx)
+		provider, err := r.newProvider(ctx, &cfg)
+		if err != nil {
+			cancel()
+			providers[cfg.ID] = &registeredProvider{config: cfg, failedAt: r.now()}
+			errs = append(errs, fmt.Errorf("OIDC provider %q: %w", cfg.ID, err))
+			continue
+		}
+		providers[cfg.ID] = &registeredProvider{config: cfg, provider: provider, cancel: cancel}
+	}
+	for id, existing := range current {
+		if _, ok := providers[id]; !ok && existing.cancel != nil {
+			stale = append(stale, existing.cancel)
+		}
+	}
+
+	r.mu.Lock()
+	r.providers = providers
+	r.order = order
+	r.mu.Unlock()
+	for _, cancel := range stale {
+		cancel()
+	}
+	return errs
+}
+
+// impersonationConflict returns the ID of a provider in order that impersonates users cfg could impersonate
+// too, empty if there is none or cfg does not impersonate.
+func impersonationConflict(order []string, providers map[string]*registeredProvider, cfg *Config) string {
+	if cfg.Impersonation == nil {
+		return ""
+	}
+	impersonation := cfg.Impersonation.withDefaults(cfg.ID)
+	for _, id := range order {
+		other := providers[id].config
+		if other.Impersonation != nil && impersonation.overlaps(other.Impersonation.withDefaults(id)) {
+			return id
+		}
+	}
+	return ""
+}
+
+// List returns the providers users can log in with, in configuration order.
+func (r *Registry) List() []ProviderInfo {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	infos := make([]ProviderInfo, 0, len(r.order))
+	for _, id := range r.order {
+		if p := r.providers[id].provider; p != nil {
+			infos = append(infos, ProviderInfo{ID: p.ID(), DisplayName: p.DisplayName()})
+		}
+	}
+	return infos
+}
+
+// Get returns the provider with id.
+func (r *Registry) Get(id string) (*Provider, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if existing, ok := r.providers[id]; ok && existing.provider != nil {
+		return existing.provider, nil
+	}
+	return nil, fmt.Errorf("OIDC provider %q is not available", id)
+}
+
+// GenerateAuthURL starts a login with the provider with id. An empty id selects the only provider.
+func (r *Registry) GenerateAuthURL(id string) (authURL string, state string, err error) {
+	if id == "" {
+		providers := r.List()
+		if len(providers) != 1 {
+			return "", "", fmt.Errorf("an OIDC provider must be chosen out of %d", len(providers))
+		}
+		id = providers[0].ID
+	}
+	provider, err := r.Get(id)
+	if err != nil {
+		return "", "", err
+	}
+	return provider.GenerateAuthURL()
+}
+
+// ProviderForState returns the provider a login state belongs to.
+func (r *Registry) ProviderForState(state string) (*Provider, error) {
+	id, _, found := strings.Cut(state, stateSeparator)
+	if !found {
+		return nil, fmt.Errorf("state parameter does not name an OIDC provider")
+	}
+	return r.Get(id)
+}
+
+// Refresh redeems a refresh token with the provider that issued it.
+func (r *Registry) Refresh(ctx context.Context, providerID, refreshToken string) (*Tokens, error) {
+	provider, err := r.Get(providerID)
+	if err != nil {
+		return nil, err
+	}
+	return provider.Refresh(ctx, refreshToken)
+}
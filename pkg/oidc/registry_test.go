@@ -0,0 +1,174 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oidc
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+func fakeProvider(cfg *Config) *Provider {
+	return &Provider{
+		id:          cfg.ID,
+		displayName: cfg.DisplayName,
+		oauth2Config: &oauth2.Config{
+			ClientID: cfg.ClientID,
+			Endpoint: oauth2.Endpoint{AuthURL: cfg.IssuerURL + "/auth"},
+		},
+		stateKey: []byte("state-key"),
+		pkceKey:  []byte("pkce-key"),
+	}
+}
+
+func TestRegistrySync(t *testing.T) {
+	now := time.Now()
+	discovered := map[string]int{}
+	r := NewRegistry(context.TODO())
+	r.now = func() time.Time { return now }
+	r.newProvider = func(_ context.Context, cfg *Config) (*Provider, error) {
+		discovered[cfg.ID]++
+		if strings.Contains(cfg.IssuerURL, "down") {
+			return nil, errors.New("discovery failed")
+		}
+		return fakeProvider(cfg), nil
+	}
+
+	configs := []Config{
+		{ID: "corp", DisplayName: "Corporate SSO", IssuerURL: "https://corp.example.com", ClientID: "dashboard"},
+		{ID: "partner", DisplayName: "Partner", IssuerURL: "https://down.example.com", ClientID: "dashboard"},
+		{ID: "Bad:ID", IssuerURL: "https://bad.example.com"},
+	}
+	if errs := r.Sync(configs); len(errs) != 2 {
+		t.Errorf("Sync() errors = %v, want the failed discovery and the invalid ID", errs)
+	}
+	if got, want := r.List(), []ProviderInfo{{ID: "corp", DisplayName: "Corporate SSO"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("List() = %v, want %v", got, want)
+	}
+
+	// Unchanged providers are kept and failed ones are not retried right away.
+	r.Sync(configs)
+	if discovered["corp"] != 1 || discovered["partner"] != 1 {
+		t.Errorf("discovery runs = %v, want one each", discovered)
+	}
+	now = now.Add(retryInterval)
+	configs[1].IssuerURL = "https://partner.example.com"
+	if errs := r.Sync(configs[:2]); len(errs) != 0 {
+		t.Errorf("Sync() errors = %v, want none", errs)
+	}
+	if got := len(r.List()); got != 2 {
+		t.Errorf("List() has %d providers, want 2", got)
+	}
+
+	r.Sync(configs[1:2])
+	if _, err := r.Get("corp"); err == nil {
+		t.Errorf("Get() of a removed provider should fail")
+	}
+}
+
+func TestRegistrySyncDoesNotBlockReaders(t *testing.T) {
+	r := NewRegistry(context.TODO())
+	r.newProvider = func(_ context.Context, cfg *Config) (*Provider, error) {
+		return fakeProvider(cfg), nil
+	}
+	r.Sync([]Config{{ID: "corp", IssuerURL: "https://corp.example.com"}})
+
+	discovering := make(chan struct{})
+	release := make(chan struct{})
+	r.newProvider = func(_ context.Context, cfg *Config) (*Provider, error) {
+		close(discovering)
+		<-release
+		return fakeProvider(cfg), nil
+	}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		r.Sync([]Config{{ID: "corp", IssuerURL: "https://corp.example.com"}, {ID: "partner", IssuerURL: "https://partner.example.com"}})
+	}()
+	<-discovering
+	if _, err := r.Get("corp"); err != nil {
+		t.Errorf("Get() during discovery error = %v", err)
+	}
+	if got := len(r.List()); got != 1 {
+		t.Errorf("List() during discovery has %d providers, want 1", got)
+	}
+	close(release)
+	<-done
+	if got := len(r.List()); got != 2 {
+		t.Errorf("List() after Sync has %d providers, want 2", got)
+	}
+}
+
+func TestRegistryLoginState(t *testing.T) {
+	r := NewRegistry(context.TODO())
+	r.newProvider = func(_ context.Context, cfg *Config) (*Provider, error) {
+		return fakeProvider(cfg), nil
+	}
+	r.Sync([]Config{{ID: "corp", IssuerURL: "https://corp.example.com"}})
+
+	authURL, state, err := r.GenerateAuthURL("")
+	if err != nil {
+		t.Fatalf("GenerateAuthURL() error = %v", err)
+	}
+	if !strings.HasPrefix(state, "corp:") {
+		t.Errorf("state = %q, want it prefixed with the provider ID", state)
+	}
+	provider, err := r.ProviderForState(state)
+	if err != nil {
+		t.Fatalf("ProviderForState() error = %v", err)
+	}
+	if provider.ID() != "corp" {
+		t.Errorf("ProviderForState() provider = %q, want corp", provider.ID())
+	}
+	if err := provider.ValidateState(state); err != nil {
+		t.Errorf("ValidateState() error = %v", err)
+	}
+	_, otherState, err := r.GenerateAuthURL("corp")
+	if err != nil {
+		t.Fatalf("GenerateAuthURL() error = %v", err)
+	}
+	provider.states.Delete(otherState)
+	if err := provider.ValidateState(otherState); err != nil {
+		t.Errorf("ValidateState() on another instance error = %v", err)
+	}
+
+	parsed, err := url.Parse(authURL)
+	if err != nil {
+		t.Fatalf("parse auth URL: %v", err)
+	}
+	query := parsed.Query()
+	if query.Get("state") != state {
+		t.Errorf("auth URL state = %q, want %q", query.Get("state"), state)
+	}
+	if query.Get("code_challenge_method") != "S256" {
+		t.Errorf("code_challenge_method = %q, want S256", query.Get("code_challenge_method"))
+	}
+	// The verifier used in the code exchange is derived from the state again.
+	if want := oauth2.S256ChallengeFromVerifier(provider.codeVerifier(state)); query.Get("code_challenge") != want {
+		t.Errorf("code_challenge = %q, want %q", query.Get("code_challenge"), want)
+	}
+
+	if _, err := r.ProviderForState("unknown" + strings.TrimPrefix(state, "corp")); err == nil {
+		t.Errorf("ProviderForState() with an unknown provider should fail")
+	}
+}
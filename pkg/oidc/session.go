@@ -0,0 +1,236 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oidc
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// SessionCookieName is the name of the cookie holding the encrypted session ID.
+const SessionCookieName = "karmada-dashboard-session"
+
+// refreshSkew refreshes ID tokens a little before they expire, so a token does not expire in flight.
+const refreshSkew = 30 * time.Second
+
+// ErrSessionNotFound is returned for missing, tampered, revoked or expired sessions.
+var ErrSessionNotFound = errors.New("session not found or expired")
+
+// SessionConfig holds the session cookie configuration.
+type SessionConfig struct {
+	// Secret is the key material for encrypting session cookies. A random key is used if empty.
+	Secret string
+	// MaxAge is the absolute lifetime of a session, regardless of refreshes.
+	MaxAge time.Duration
+	// SecureCookie restricts the session cookie to HTTPS.
+	SecureCookie bool
+}
+
+// session is a logged-in user. The mutex serializes refreshes, so a rotated refresh token is only redeemed once.
+type session struct {
+	mu        sync.Mutex
+	tokens    Tokens
+	createdAt time.Time
+}
+
+// SessionManager keeps OIDC sessions server-side. The browser only holds the session ID, encrypted
+// in an HttpOnly cookie, while ID and refresh tokens stay on the server. Sessions live in memory, so
+// they end when the server restarts and are not shared between replicas.
+type SessionManager struct {
+	aead         cipher.AEAD
+	maxAge       time.Duration
+	secureCookie bool
+	refresh      func(ctx context.Context, providerID, refreshToken string) (*Tokens, error)
+	now          func() time.Time
+
+	mu       sync.Mutex
+	sessions map[string]*session
+}
+
+// NewSessionManager returns a session manager refreshing ID tokens with the providers of registry.
+func NewSessionManager(registry *Registry, cfg *SessionConfig) (*SessionManager, error) {
+	key := make([]byte, sha256.Size)
+	if cfg.Secret == "" {
+		if _, err := rand.Read(key); err != nil {
+			return nil, fmt.Errorf("failed to generate session key: %w", err)
+		}
+	} else {
+		sum := sha256.Sum256([]byte(cfg.Secret))
+		key = sum[:]
+	}
+	if cfg.MaxAge <= 0 {
+		return nil, fmt.Errorf("session max age must be positive")
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &SessionManager{
+		aead:         aead,
+		maxAge:       cfg.MaxAge,
+		secureCookie: cfg.SecureCookie,
+		refresh:      registry.Refresh,
+		now:          time.Now,
+		sessions:     map[string]*session{},
+	}, nil
+}
+
+// Create starts a session for tokens and returns the cookie identifying it.
+func (m *SessionManager) Create(tokens *Tokens) (*http.Cookie, error) {
+	id := make([]byte, 32)
+	if _, err := rand.Read(id); err != nil {
+		return nil, fmt.Errorf("failed to generate session ID: %w", err)
+	}
+	value, err := m.encrypt(id)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := m.now()
+	for key, s := range m.sessions {
+		if m.expired(s, now) {
+			delete(m.sessions, key)
+		}
+	}
+	m.sessions[string(id)] = &session{tokens: *tokens, createdAt: now}
+	return m.cookie(value, int(m.maxAge.Seconds())), nil
+}
+
+// IDToken returns the ID token of the request's session, refreshing it first if it is about to expire.
+// A session whose refresh fails is revoked.
+func (m *SessionManager) IDToken(ctx context.Context, request *http.Request) (string, error) {
+	id, s := m.lookup(request)
+	if s == nil {
+		return "", ErrSessionNotFound
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if m.now().Add(refreshSkew).Before(s.tokens.Expiry) {
+		return s.tokens.IDToken, nil
+	}
+	if s.tokens.RefreshToken == "" {
+		m.delete(id)
+		return "", ErrSessionNotFound
+	}
+	tokens, err := m.refresh(ctx, s.tokens.ProviderID, s.tokens.RefreshToken)
+	if err != nil {
+		m.delete(id)
+		return "", err
+	}
+	s.tokens = *tokens
+	return s.tokens.IDToken, nil
+}
+
+// Revoke ends the request's session and returns its last tokens, nil if there was no session.
+func (m *SessionManager) Revoke(request *http.Request) *Tokens {
+	id, s := m.lookup(request)
+	if s == nil {
+		return nil
+	}
+	m.delete(id)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	tokens := s.tokens
+	return &tokens
+}
+
+// ExpiredCookie returns a cookie that removes the session cookie from the browser.
+func (m *SessionManager) ExpiredCookie() *http.Cookie {
+	return m.cookie("", -1)
+}
+
+func (m *SessionManager) lookup(request *http.Request) (string, *session) {
+	cookie, err := request.Cookie(SessionCookieName)
+	if err != nil {
+		return "", nil
+	}
+	id, err := m.decrypt(cookie.Value)
+	if err != nil {
+		return "", nil
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s, ok := m.sessions[string(id)]
+	if !ok {
+		return "", nil
+	}
+	if m.expired(s, m.now()) {
+		delete(m.sessions, string(id))
+		return "", nil
+	}
+	return string(id), s
+}
+
+func (m *SessionManager) delete(id string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.sessions, id)
+}
+
+func (m *SessionManager) expired(s *session, now time.Time) bool {
+	return now.Sub(s.createdAt) > m.maxAge
+}
+
+func (m *SessionManager) cookie(value string, maxAge int) *http.Cookie {
+	return &http.Cookie{
+		Name:     SessionCookieName,
+		Value:    value,
+		Path:     "/",
+		MaxAge:   maxAge,
+		HttpOnly: true,
+		Secure:   m.secureCookie,
+		SameSite: http.SameSiteStrictMode,
+	}
+}
+
+func (m *SessionManager) encrypt(id []byte) (string, error) {
+	nonce := make([]byte, m.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	sealed := m.aead.Seal(nonce, nonce, id, []byte(SessionCookieName))
+	return base64.RawURLEncoding.EncodeToString(sealed), nil
+}
+
+func (m *SessionManager) decrypt(value string) ([]byte, error) {
+	sealed, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < m.aead.NonceSize() {
+		return nil, ErrSessionNotFound
+	}
+	nonce, ciphertext := sealed[:m.aead.NonceSize()], sealed[m.aead.NonceSize():]
+	return m.aead.Open(nil, nonce, ciphertext, []byte(SessionCookieName))
+}
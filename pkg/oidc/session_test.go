@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oidc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func newTestSessionManager(t *testing.T, now *time.Time) *SessionManager {
+	m, err := NewSessionManager(nil, &SessionConfig{Secret: "test-secret", MaxAge: time.Hour})
+	if err != nil {
+		t.Fatalf("NewSessionManager() error = %v", err)
+	}
+	m.now = func() time.Time { return *now }
+	return m
+}
+
+func requestWithCookie(cookie *http.Cookie) *http.Request {
+	request := httptest.NewRequest(http.MethodGet, "/api/v1/me", nil)
+	request.AddCookie(cookie)
+	return request
+}
+
+func TestSessionManagerRefresh(t *testing.T) {
+	now := time.Now()
+	m := newTestSessionManager(t, &now)
+	var refreshed []string
+	m.refresh = func(_ context.Context, providerID, refreshToken string) (*Tokens, error) {
+		refreshed = append(refreshed, providerID+"/"+refreshToken)
+		return &Tokens{ProviderID: providerID, IDToken: "id-2", RefreshToken: "refresh-2", Expiry: now.Add(10 * time.Minute)}, nil
+	}
+
+	cookie, err := m.Create(&Tokens{ProviderID: "corp", IDToken: "id-1", RefreshToken: "refresh-1", Expiry: now.Add(5 * time.Minute)})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if !cookie.HttpOnly || cookie.Value == "" {
+		t.Fatalf("session cookie = %+v, want an HttpOnly cookie with a value", cookie)
+	}
+
+	if token, err := m.IDToken(context.TODO(), requestWithCookie(cookie)); err != nil || token != "id-1" {
+		t.Errorf("IDToken() = %q, %v, want id-1", token, err)
+	}
+	now = now.Add(5 * time.Minute)
+	if token, err := m.IDToken(context.TODO(), requestWithCookie(cookie)); err != nil || token != "id-2" {
+		t.Errorf("IDToken() after expiry = %q, %v, want id-2", token, err)
+	}
+	if token, err := m.IDToken(context.TODO(), requestWithCookie(cookie)); err != nil || token != "id-2" {
+		t.Errorf("IDToken() after refresh = %q, %v, want id-2", token, err)
+	}
+	if len(refreshed) != 1 || refreshed[0] != "corp/refresh-1" {
+		t.Errorf("refreshed with %v, want [corp/refresh-1]", refreshed)
+	}
+
+	now = now.Add(time.Hour)
+	if _, err := m.IDToken(context.TODO(), requestWithCookie(cookie)); !errors.Is(err, ErrSessionNotFound) {
+		t.Errorf("IDToken() past max age error = %v, want ErrSessionNotFound", err)
+	}
+}
+
+func TestSessionManagerFailedRefreshRevokes(t *testing.T) {
+	now := time.Now()
+	m := newTestSessionManager(t, &now)
+	m.refresh = func(context.Context, string, string) (*Tokens, error) {
+		return nil, errors.New("invalid_grant")
+	}
+
+	cookie, err := m.Create(&Tokens{IDToken: "id-1", RefreshToken: "refresh-1", Expiry: now})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if _, err := m.IDToken(context.TODO(), requestWithCookie(cookie)); err == nil {
+		t.Fatalf("IDToken() with a failing refresh should fail")
+	}
+	if _, err := m.IDToken(context.TODO(), requestWithCookie(cookie)); !errors.Is(err, ErrSessionNotFound) {
+		t.Errorf("IDToken() after failed refresh error = %v, want ErrSessionNotFound", err)
+	}
+}
+
+func TestSessionManagerRevokeAndTamper(t *testing.T) {
+	now := time.Now()
+	m := newTestSessionManager(t, &now)
+
+	cookie, err := m.Create(&Tokens{IDToken: "id-1", Expiry: now.Add(time.Hour)})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	tampered := *cookie
+	flipped := byte('A')
+	if cookie.Value[0] == flipped {
+		flipped = 'B'
+	}
+	tampered.Value = string(flipped) + cookie.Value[1:]
+	if _, err := m.IDToken(context.TODO(), requestWithCookie(&tampered)); !errors.Is(err, ErrSessionNotFound) {
+		t.Errorf("IDToken() with a tampered cookie error = %v, want ErrSessionNotFound", err)
+	}
+
+	if tokens := m.Revoke(requestWithCookie(cookie)); tokens == nil || tokens.IDToken != "id-1" {
+		t.Errorf("Revoke() = %v, want the tokens of id-1", tokens)
+	}
+	if _, err := m.IDToken(context.TODO(), requestWithCookie(cookie)); !errors.Is(err, ErrSessionNotFound) {
+		t.Errorf("IDToken() after revoke error = %v, want ErrSessionNotFound", err)
+	}
+	if expired := m.ExpiredCookie(); expired.MaxAge >= 0 {
+		t.Errorf("ExpiredCookie().MaxAge = %d, want negative", expired.MaxAge)
+	}
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package aggregated lists resources of many member clusters at once and merges them into one data select.
+package aggregated
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	karmadautil "github.com/karmada-io/karmada/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// maxConcurrentClusters bounds how many member clusters are queried at the same time.
+	maxConcurrentClusters = 10
+	// clusterTimeout bounds the time spent listing resources of a single member cluster.
+	clusterTimeout = 10 * time.Second
+)
+
+// ClientForCluster returns a client for the member cluster with the given name.
+type ClientForCluster func(clusterName string) (kubernetes.Interface, error)
+
+// ClusterError is a failure to list resources of a single member cluster. It is reported as a non-critical error.
+type ClusterError struct {
+	Cluster string `json:"cluster"`
+	Err     error  `json:"-"`
+}
+
+func (e *ClusterError) Error() string {
+	return fmt.Sprintf("cluster %s: %v", e.Cluster, e.Err)
+}
+
+func (e *ClusterError) Unwrap() error {
+	return e.Err
+}
+
+// MarshalJSON keeps the message of the wrapped error, as errors are reported to the client as JSON.
+func (e *ClusterError) MarshalJSON() ([]byte, error) {
+	return []byte(fmt.Sprintf("{\"cluster\":%q,\"message\":%q}", e.Cluster, e.Err.Error())), nil
+}
+
+// ListClusters returns the member clusters matching clusterSelector sorted by name, all if it is empty.
+func ListClusters(ctx context.Context, karmadaClient karmadaclientset.Interface, clusterSelector string) ([]clusterv1alpha1.Cluster, error) {
+	if _, err := labels.Parse(clusterSelector); err != nil {
+		return nil, err
+	}
+	clusters, err := karmadaClient.ClusterV1alpha1().Clusters().List(ctx, metav1.ListOptions{LabelSelector: clusterSelector})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(clusters.Items, func(i, j int) bool {
+		return clusters.Items[i].Name < clusters.Items[j].Name
+	})
+	return clusters.Items, nil
+}
+
+// clusterNames returns the names of clusters.
+func clusterNames(clusters []clusterv1alpha1.Cluster) []string {
+	names := make([]string, 0, len(clusters))
+	for _, cluster := range clusters {
+		names = append(names, cluster.Name)
+	}
+	return names
+}
+
+// fanOut calls list for every ready cluster concurrently and returns the results in the order of clusters. Each call
+// gets clusterTimeout, so an unreachable cluster cannot hold up the others. Clusters that are not ready or failed
+// are left out of the results and reported as ClusterErrors.
+func fanOut[T any](ctx context.Context, clusters []clusterv1alpha1.Cluster, clientFor ClientForCluster,
+	list func(ctx context.Context, clusterName string, client kubernetes.Interface) ([]T, error)) ([][]T, []error) {
+	results := make([][]T, len(clusters))
+	errs := make([]error, len(clusters))
+	sem := make(chan struct{}, maxConcurrentClusters)
+	var wg sync.WaitGroup
+	for i := range clusters {
+		clusterName := clusters[i].Name
+		if !karmadautil.IsClusterReady(&clusters[i].Status) {
+			errs[i] = &ClusterError{Cluster: clusterName, Err: fmt.Errorf("cluster is not ready")}
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			clusterCtx, cancel := context.WithTimeout(ctx, clusterTimeout)
+			defer cancel()
+			client, err := clientFor(clusterName)
+			if err == nil {
+				results[i], err = list(clusterCtx, clusterName, client)
+			}
+			if err != nil {
+				errs[i] = &ClusterError{Cluster: clusterName, Err: err}
+			}
+		}()
+	}
+	wg.Wait()
+
+	nonCriticalErrors := make([]error, 0)
+	for _, err := range errs {
+		if err != nil {
+			nonCriticalErrors = append(nonCriticalErrors, err)
+		}
+	}
+	return results, nonCriticalErrors
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aggregated
+
+import (
+	"context"
+
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	apps "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/karmada-io/dashboard/pkg/common/types"
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+	"github.com/karmada-io/dashboard/pkg/resource/common"
+)
+
+// Deployment is a deployment of a member cluster.
+type Deployment struct {
+	ObjectMeta        types.ObjectMeta `json:"objectMeta"`
+	TypeMeta          types.TypeMeta   `json:"typeMeta"`
+	Cluster           string           `json:"cluster"`
+	Replicas          int32            `json:"replicas"`
+	ReadyReplicas     int32            `json:"readyReplicas"`
+	UpdatedReplicas   int32            `json:"updatedReplicas"`
+	AvailableReplicas int32            `json:"availableReplicas"`
+	ContainerImages   []string         `json:"containerImages"`
+}
+
+// DeploymentList contains the deployments of many member clusters.
+type DeploymentList struct {
+	ListMeta    types.ListMeta `json:"listMeta"`
+	Deployments []Deployment   `json:"deployments"`
+	// Clusters are the member clusters matching the selector, those that could not be queried are reported in Errors.
+	Clusters []string `json:"clusters"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// DeploymentCell wraps Deployment for data selection.
+type DeploymentCell Deployment
+
+// GetProperty returns a property.
+func (c DeploymentCell) GetProperty(name dataselect.PropertyName) dataselect.ComparableValue {
+	switch name {
+	case dataselect.NameProperty:
+		return dataselect.StdComparableString(c.ObjectMeta.Name)
+	case dataselect.CreationTimestampProperty:
+		return dataselect.StdComparableTime(c.ObjectMeta.CreationTimestamp.Time)
+	case dataselect.NamespaceProperty:
+		return dataselect.StdComparableString(c.ObjectMeta.Namespace)
+	case dataselect.ClusterProperty:
+		return dataselect.StdComparableString(c.Cluster)
+	default:
+		// if name is not supported then just return a constant dummy value, sort will have no effect.
+		return nil
+	}
+}
+
+// GetDeploymentList lists the deployments of the member clusters matching clusterSelector and applies dsQuery to
+// all of them.
+func GetDeploymentList(ctx context.Context, karmadaClient karmadaclientset.Interface, clientFor ClientForCluster,
+	clusterSelector string, nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) (*DeploymentList, error) {
+	clusters, err := ListClusters(ctx, karmadaClient, clusterSelector)
+	if err != nil {
+		return nil, err
+	}
+	perCluster, nonCriticalErrors := fanOut(ctx, clusters, clientFor, func(ctx context.Context, clusterName string, client kubernetes.Interface) ([]Deployment, error) {
+		deployments, err := client.AppsV1().Deployments(nsQuery.ToRequestParam()).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		items := make([]Deployment, 0, len(deployments.Items))
+		for i := range deployments.Items {
+			if nsQuery.Matches(deployments.Items[i].Namespace) {
+				items = append(items, toDeployment(clusterName, &deployments.Items[i]))
+			}
+		}
+		return items, nil
+	})
+
+	var cells []dataselect.DataCell
+	for _, deployments := range perCluster {
+		for _, deployment := range deployments {
+			cells = append(cells, DeploymentCell(deployment))
+		}
+	}
+	selected, filteredTotal := dataselect.GenericDataSelectWithFilter(cells, dsQuery)
+	result := &DeploymentList{
+		ListMeta:    types.ListMeta{TotalItems: filteredTotal},
+		Deployments: make([]Deployment, 0, len(selected)),
+		Clusters:    clusterNames(clusters),
+		Errors:      nonCriticalErrors,
+	}
+	for _, cell := range selected {
+		result.Deployments = append(result.Deployments, Deployment(cell.(DeploymentCell)))
+	}
+	return result, nil
+}
+
+func toDeployment(clusterName string, deployment *apps.Deployment) Deployment {
+	result := Deployment{
+		ObjectMeta:        types.NewObjectMeta(deployment.ObjectMeta),
+		TypeMeta:          types.NewTypeMeta(types.ResourceKindDeployment),
+		Cluster:           clusterName,
+		ReadyReplicas:     deployment.Status.ReadyReplicas,
+		UpdatedReplicas:   deployment.Status.UpdatedReplicas,
+		AvailableReplicas: deployment.Status.AvailableReplicas,
+		ContainerImages:   common.GetContainerImages(&deployment.Spec.Template.Spec),
+	}
+	if deployment.Spec.Replicas != nil {
+		result.Replicas = *deployment.Spec.Replicas
+	}
+	return result
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aggregated
+
+import (
+	"context"
+
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/karmada-io/dashboard/pkg/common/types"
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+	"github.com/karmada-io/dashboard/pkg/resource/common"
+)
+
+// Pod is a pod of a member cluster.
+type Pod struct {
+	ObjectMeta types.ObjectMeta `json:"objectMeta"`
+	TypeMeta   types.TypeMeta   `json:"typeMeta"`
+	Cluster    string           `json:"cluster"`
+	Phase      v1.PodPhase      `json:"phase"`
+	// Status is the reason kubectl shows for the pod, e.g. Running, CrashLoopBackOff or Terminating.
+	Status          string `json:"status"`
+	Restarts        int32  `json:"restarts"`
+	ReadyContainers int    `json:"readyContainers"`
+	TotalContainers int    `json:"totalContainers"`
+	NodeName        string `json:"nodeName,omitempty"`
+}
+
+// PodList contains the pods of many member clusters.
+type PodList struct {
+	ListMeta types.ListMeta `json:"listMeta"`
+	Items    []Pod          `json:"items"`
+	// Clusters are the member clusters matching the selector, those that could not be queried are reported in Errors.
+	Clusters []string `json:"clusters"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// PodCell wraps Pod for data selection.
+type PodCell Pod
+
+// GetProperty returns a property.
+func (c PodCell) GetProperty(name dataselect.PropertyName) dataselect.ComparableValue {
+	switch name {
+	case dataselect.NameProperty:
+		return dataselect.StdComparableString(c.ObjectMeta.Name)
+	case dataselect.CreationTimestampProperty:
+		return dataselect.StdComparableTime(c.ObjectMeta.CreationTimestamp.Time)
+	case dataselect.NamespaceProperty:
+		return dataselect.StdComparableString(c.ObjectMeta.Namespace)
+	case dataselect.ClusterProperty:
+		return dataselect.StdComparableString(c.Cluster)
+	case dataselect.StatusProperty:
+		return dataselect.StdComparableString(c.Status)
+	default:
+		// if name is not supported then just return a constant dummy value, sort will have no effect.
+		return nil
+	}
+}
+
+// GetPodList lists the pods of the member clusters matching clusterSelector and applies dsQuery to all of them.
+func GetPodList(ctx context.Context, karmadaClient karmadaclientset.Interface, clientFor ClientForCluster,
+	clusterSelector string, nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) (*PodList, error) {
+	clusters, err := ListClusters(ctx, karmadaClient, clusterSelector)
+	if err != nil {
+		return nil, err
+	}
+	perCluster, nonCriticalErrors := fanOut(ctx, clusters, clientFor, func(ctx context.Context, clusterName string, client kubernetes.Interface) ([]Pod, error) {
+		pods, err := client.CoreV1().Pods(nsQuery.ToRequestParam()).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		items := make([]Pod, 0, len(pods.Items))
+		for i := range pods.Items {
+			if nsQuery.Matches(pods.Items[i].Namespace) {
+				items = append(items, toPod(clusterName, &pods.Items[i]))
+			}
+		}
+		return items, nil
+	})
+
+	var cells []dataselect.DataCell
+	for _, pods := range perCluster {
+		for _, pod := range pods {
+			cells = append(cells, PodCell(pod))
+		}
+	}
+	selected, filteredTotal := dataselect.GenericDataSelectWithFilter(cells, dsQuery)
+	result := &PodList{
+		ListMeta: types.ListMeta{TotalItems: filteredTotal},
+		Items:    make([]Pod, 0, len(selected)),
+		Clusters: clusterNames(clusters),
+		Errors:   nonCriticalErrors,
+	}
+	for _, cell := range selected {
+		result.Items = append(result.Items, Pod(cell.(PodCell)))
+	}
+	return result, nil
+}
+
+func toPod(clusterName string, pod *v1.Pod) Pod {
+	result := Pod{
+		ObjectMeta:      types.NewObjectMeta(pod.ObjectMeta),
+		TypeMeta:        types.NewTypeMeta(types.ResourceKindPod),
+		Cluster:         clusterName,
+		Phase:           pod.Status.Phase,
+		Status:          podStatus(pod),
+		TotalContainers: len(pod.Spec.Containers),
+		NodeName:        pod.Spec.NodeName,
+	}
+	for _, status := range pod.Status.ContainerStatuses {
+		result.Restarts += status.RestartCount
+		if status.Ready {
+			result.ReadyContainers++
+		}
+	}
+	return result
+}
+
+// podStatus mirrors the STATUS column of kubectl get pods: the reason of the first waiting or terminated
+// container wins over the pod phase.
+func podStatus(pod *v1.Pod) string {
+	if pod.DeletionTimestamp != nil {
+		return "Terminating"
+	}
+	for _, status := range pod.Status.InitContainerStatuses {
+		if status.State.Waiting != nil && status.State.Waiting.Reason != "" && status.State.Waiting.Reason != "PodInitializing" {
+			return "Init:" + status.State.Waiting.Reason
+		}
+		if status.State.Terminated != nil && status.State.Terminated.ExitCode != 0 {
+			return "Init:" + terminatedReason(status.State.Terminated)
+		}
+	}
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.State.Waiting != nil && status.State.Waiting.Reason != "" {
+			return status.State.Waiting.Reason
+		}
+		if status.State.Terminated != nil {
+			return terminatedReason(status.State.Terminated)
+		}
+	}
+	if pod.Status.Reason != "" {
+		return pod.Status.Reason
+	}
+	return string(pod.Status.Phase)
+}
+
+func terminatedReason(state *v1.ContainerStateTerminated) string {
+	if state.Reason != "" {
+		return state.Reason
+	}
+	if state.Signal != 0 {
+		return "Signal"
+	}
+	return "ExitCode"
+}
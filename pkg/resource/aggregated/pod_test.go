@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aggregated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	karmadafake "github.com/karmada-io/karmada/pkg/generated/clientset/versioned/fake"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+	"github.com/karmada-io/dashboard/pkg/resource/common"
+)
+
+func crashingPod(name string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec:       v1.PodSpec{Containers: []v1.Container{{Name: "app"}}},
+		Status: v1.PodStatus{
+			Phase: v1.PodRunning,
+			ContainerStatuses: []v1.ContainerStatus{{
+				Name:         "app",
+				RestartCount: 7,
+				State:        v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+			}},
+		},
+	}
+}
+
+func memberCluster(name, env string, ready metav1.ConditionStatus) *clusterv1alpha1.Cluster {
+	return &clusterv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"env": env}},
+		Status: clusterv1alpha1.ClusterStatus{
+			Conditions: []metav1.Condition{{Type: clusterv1alpha1.ClusterConditionReady, Status: ready}},
+		},
+	}
+}
+
+func TestGetPodList(t *testing.T) {
+	karmadaClient := karmadafake.NewSimpleClientset(
+		memberCluster("member1", "prod", metav1.ConditionTrue),
+		memberCluster("member2", "prod", metav1.ConditionTrue),
+		memberCluster("member3", "prod", metav1.ConditionTrue),
+		memberCluster("member4", "prod", metav1.ConditionFalse),
+		memberCluster("dev", "dev", metav1.ConditionTrue),
+	)
+	memberClients := map[string]kubernetes.Interface{
+		"member1": k8sfake.NewSimpleClientset(crashingPod("web-1"), &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "web-2", Namespace: "default"},
+			Status:     v1.PodStatus{Phase: v1.PodRunning},
+		}),
+		"member2": k8sfake.NewSimpleClientset(crashingPod("api-1")),
+		"dev":     k8sfake.NewSimpleClientset(crashingPod("dev-1")),
+	}
+	clientFor := func(clusterName string) (kubernetes.Interface, error) {
+		if clusterName == "member4" {
+			t.Errorf("clientFor() called for a cluster that is not ready")
+		}
+		if client, ok := memberClients[clusterName]; ok {
+			return client, nil
+		}
+		return nil, fmt.Errorf("unreachable")
+	}
+	dsQuery := dataselect.NewDataSelectQuery(
+		dataselect.NoPagination,
+		dataselect.NewSortQuery([]string{"a", "cluster"}),
+		dataselect.NewFilterQuery([]string{"status", "CrashLoopBackOff"}),
+	)
+
+	result, err := GetPodList(context.TODO(), karmadaClient, clientFor, "env=prod", common.NewNamespaceQuery(nil), dsQuery)
+	if err != nil {
+		t.Fatalf("GetPodList() error = %v", err)
+	}
+	var got []string
+	for _, pod := range result.Items {
+		got = append(got, pod.Cluster+"/"+pod.ObjectMeta.Name)
+		if pod.Restarts != 7 {
+			t.Errorf("pod %s restarts = %d, want 7", pod.ObjectMeta.Name, pod.Restarts)
+		}
+	}
+	if want := []string{"member1/web-1", "member2/api-1"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("pods = %v, want %v", got, want)
+	}
+	if result.ListMeta.TotalItems != 2 {
+		t.Errorf("totalItems = %d, want 2", result.ListMeta.TotalItems)
+	}
+	var failed []string
+	for _, err := range result.Errors {
+		var clusterErr *ClusterError
+		if !errors.As(err, &clusterErr) {
+			t.Fatalf("error %v is not a ClusterError", err)
+		}
+		failed = append(failed, clusterErr.Cluster)
+	}
+	if want := []string{"member3", "member4"}; !reflect.DeepEqual(failed, want) {
+		t.Errorf("failed clusters = %v, want %v", failed, want)
+	}
+}
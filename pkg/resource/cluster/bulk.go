@@ -0,0 +1,232 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/util/retry"
+)
+
+// CordonTaintKey is the key of the taints a cordon adds to a cluster.
+const CordonTaintKey = "dashboard.karmada.io/cordoned"
+
+// BulkOperationType is the kind of change a bulk operation makes to a cluster.
+type BulkOperationType string
+
+const (
+	// BulkOperationAddLabel sets a label, overwriting its value if present.
+	BulkOperationAddLabel BulkOperationType = "addLabel"
+	// BulkOperationRemoveLabel removes a label.
+	BulkOperationRemoveLabel BulkOperationType = "removeLabel"
+	// BulkOperationAddTaint adds a taint, overwriting the value of a taint with the same key and effect.
+	BulkOperationAddTaint BulkOperationType = "addTaint"
+	// BulkOperationRemoveTaint removes the taints with a key, of a single effect if one is given.
+	BulkOperationRemoveTaint BulkOperationType = "removeTaint"
+	// BulkOperationCordon adds the NoSchedule cordon taint, and the NoExecute one to evict workloads if Evict is set.
+	BulkOperationCordon BulkOperationType = "cordon"
+	// BulkOperationUncordon removes the cordon taints.
+	BulkOperationUncordon BulkOperationType = "uncordon"
+)
+
+// BulkOperation is a single label or taint change applied to every targeted cluster.
+type BulkOperation struct {
+	Op     BulkOperationType  `json:"op" binding:"required"`
+	Key    string             `json:"key"`
+	Value  string             `json:"value"`
+	Effect corev1.TaintEffect `json:"effect"`
+	Evict  bool               `json:"evict"`
+}
+
+// BulkResult is the outcome of a bulk operation on one cluster.
+type BulkResult struct {
+	ClusterName string `json:"clusterName"`
+	Succeeded   bool   `json:"succeeded"`
+	// Changed is false if the cluster already was in the requested state.
+	Changed bool   `json:"changed"`
+	Error   string `json:"error,omitempty"`
+}
+
+// ValidateBulkOperations checks the operations before any cluster is touched.
+func ValidateBulkOperations(operations []BulkOperation) error {
+	if len(operations) == 0 {
+		return fmt.Errorf("no operations given")
+	}
+	for i, op := range operations {
+		switch op.Op {
+		case BulkOperationAddLabel, BulkOperationRemoveLabel, BulkOperationRemoveTaint:
+			if op.Key == "" {
+				return fmt.Errorf("operation %d (%s) requires a key", i, op.Op)
+			}
+		case BulkOperationAddTaint:
+			if op.Key == "" {
+				return fmt.Errorf("operation %d (%s) requires a key", i, op.Op)
+			}
+			switch op.Effect {
+			case corev1.TaintEffectNoSchedule, corev1.TaintEffectPreferNoSchedule, corev1.TaintEffectNoExecute:
+			default:
+				return fmt.Errorf("operation %d (%s) has invalid taint effect %q", i, op.Op, op.Effect)
+			}
+		case BulkOperationCordon, BulkOperationUncordon:
+		default:
+			return fmt.Errorf("operation %d has unknown op %q", i, op.Op)
+		}
+	}
+	return nil
+}
+
+// BulkUpdateClusters applies the operations to the clusters named in clusterNames and to those matching
+// labelSelector. Clusters are updated one by one and a failure on one does not stop the others.
+func BulkUpdateClusters(ctx context.Context, client karmadaclientset.Interface, clusterNames []string, labelSelector string,
+	operations []BulkOperation) ([]BulkResult, error) {
+	if err := ValidateBulkOperations(operations); err != nil {
+		return nil, err
+	}
+	targets, err := bulkTargets(ctx, client, clusterNames, labelSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]BulkResult, 0, len(targets))
+	for _, name := range targets {
+		result := BulkResult{ClusterName: name}
+		err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			cluster, err := client.ClusterV1alpha1().Clusters().Get(ctx, name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			if result.Changed = applyBulkOperations(cluster, operations); !result.Changed {
+				return nil
+			}
+			_, err = client.ClusterV1alpha1().Clusters().Update(ctx, cluster, metav1.UpdateOptions{})
+			return err
+		})
+		if err != nil {
+			result.Changed = false
+			result.Error = err.Error()
+		} else {
+			result.Succeeded = true
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// bulkTargets returns the sorted union of the named clusters and the clusters matching labelSelector.
+func bulkTargets(ctx context.Context, client karmadaclientset.Interface, clusterNames []string, labelSelector string) ([]string, error) {
+	if len(clusterNames) == 0 && labelSelector == "" {
+		return nil, fmt.Errorf("either cluster names or a label selector is required")
+	}
+	targets := map[string]bool{}
+	for _, name := range clusterNames {
+		targets[name] = true
+	}
+	if labelSelector != "" {
+		if _, err := labels.Parse(labelSelector); err != nil {
+			return nil, err
+		}
+		clusters, err := client.ClusterV1alpha1().Clusters().List(ctx, metav1.ListOptions{LabelSelector: labelSelector})
+		if err != nil {
+			return nil, err
+		}
+		for _, cluster := range clusters.Items {
+			targets[cluster.Name] = true
+		}
+	}
+	names := make([]string, 0, len(targets))
+	for name := range targets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// applyBulkOperations mutates the cluster and reports whether anything changed.
+func applyBulkOperations(cluster *v1alpha1.Cluster, operations []BulkOperation) bool {
+	changed := false
+	for _, op := range operations {
+		switch op.Op {
+		case BulkOperationAddLabel:
+			if value, ok := cluster.Labels[op.Key]; !ok || value != op.Value {
+				if cluster.Labels == nil {
+					cluster.Labels = map[string]string{}
+				}
+				cluster.Labels[op.Key] = op.Value
+				changed = true
+			}
+		case BulkOperationRemoveLabel:
+			if _, ok := cluster.Labels[op.Key]; ok {
+				delete(cluster.Labels, op.Key)
+				changed = true
+			}
+		case BulkOperationAddTaint:
+			changed = addTaint(cluster, corev1.Taint{Key: op.Key, Value: op.Value, Effect: op.Effect}) || changed
+		case BulkOperationRemoveTaint:
+			changed = removeTaints(cluster, op.Key, op.Effect) || changed
+		case BulkOperationCordon:
+			changed = addTaint(cluster, corev1.Taint{Key: CordonTaintKey, Effect: corev1.TaintEffectNoSchedule}) || changed
+			if op.Evict {
+				changed = addTaint(cluster, corev1.Taint{Key: CordonTaintKey, Effect: corev1.TaintEffectNoExecute}) || changed
+			}
+		case BulkOperationUncordon:
+			changed = removeTaints(cluster, CordonTaintKey, "") || changed
+		}
+	}
+	return changed
+}
+
+// addTaint adds the taint, or updates the value of the taint with the same key and effect.
+func addTaint(cluster *v1alpha1.Cluster, taint corev1.Taint) bool {
+	if taint.Effect == corev1.TaintEffectNoExecute {
+		now := metav1.Now()
+		taint.TimeAdded = &now
+	}
+	for i := range cluster.Spec.Taints {
+		existing := &cluster.Spec.Taints[i]
+		if existing.Key != taint.Key || existing.Effect != taint.Effect {
+			continue
+		}
+		if existing.Value == taint.Value {
+			return false
+		}
+		existing.Value = taint.Value
+		return true
+	}
+	cluster.Spec.Taints = append(cluster.Spec.Taints, taint)
+	return true
+}
+
+// removeTaints removes the taints with the key, limited to one effect unless effect is empty.
+func removeTaints(cluster *v1alpha1.Cluster, key string, effect corev1.TaintEffect) bool {
+	taints := cluster.Spec.Taints[:0]
+	for _, taint := range cluster.Spec.Taints {
+		if taint.Key == key && (effect == "" || taint.Effect == effect) {
+			continue
+		}
+		taints = append(taints, taint)
+	}
+	changed := len(taints) != len(cluster.Spec.Taints)
+	cluster.Spec.Taints = taints
+	return changed
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	karmadafake "github.com/karmada-io/karmada/pkg/generated/clientset/versioned/fake"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestBulkUpdateClusters(t *testing.T) {
+	karmadaClient := karmadafake.NewSimpleClientset(
+		&v1alpha1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "member1", Labels: map[string]string{"env": "prod", "old": "x"}},
+			Spec: v1alpha1.ClusterSpec{Taints: []corev1.Taint{
+				{Key: "maintenance", Effect: corev1.TaintEffectNoSchedule},
+				{Key: "maintenance", Effect: corev1.TaintEffectPreferNoSchedule},
+			}},
+		},
+		&v1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "member2", Labels: map[string]string{"env": "prod"}}},
+		&v1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "member3", Labels: map[string]string{"env": "dev"}}},
+	)
+
+	results, err := BulkUpdateClusters(context.TODO(), karmadaClient, []string{"missing"}, "env=prod", []BulkOperation{
+		{Op: BulkOperationAddLabel, Key: "window", Value: "2026-10"},
+		{Op: BulkOperationRemoveLabel, Key: "old"},
+		{Op: BulkOperationRemoveTaint, Key: "maintenance", Effect: corev1.TaintEffectNoSchedule},
+		{Op: BulkOperationCordon, Evict: true},
+	})
+	if err != nil {
+		t.Fatalf("BulkUpdateClusters() error = %v", err)
+	}
+	var names []string
+	for _, result := range results {
+		names = append(names, result.ClusterName)
+		if wantSucceeded := result.ClusterName != "missing"; result.Succeeded != wantSucceeded {
+			t.Errorf("result %+v, want succeeded = %t", result, wantSucceeded)
+		}
+	}
+	if want := []string{"member1", "member2", "missing"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("targets = %v, want %v", names, want)
+	}
+
+	member1, _ := karmadaClient.ClusterV1alpha1().Clusters().Get(context.TODO(), "member1", metav1.GetOptions{})
+	if want := map[string]string{"env": "prod", "window": "2026-10"}; !reflect.DeepEqual(member1.Labels, want) {
+		t.Errorf("labels = %v, want %v", member1.Labels, want)
+	}
+	var taints []string
+	for _, taint := range member1.Spec.Taints {
+		taints = append(taints, taint.Key+":"+string(taint.Effect))
+	}
+	wantTaints := []string{
+		"maintenance:PreferNoSchedule",
+		CordonTaintKey + ":NoSchedule",
+		CordonTaintKey + ":NoExecute",
+	}
+	if !reflect.DeepEqual(taints, wantTaints) {
+		t.Errorf("taints = %v, want %v", taints, wantTaints)
+	}
+
+	results, err = BulkUpdateClusters(context.TODO(), karmadaClient, []string{"member1"}, "", []BulkOperation{
+		{Op: BulkOperationCordon},
+	})
+	if err != nil || len(results) != 1 || results[0].Changed {
+		t.Errorf("cordoning a cordoned cluster = %+v, %v, want unchanged", results, err)
+	}
+
+	if _, err = BulkUpdateClusters(context.TODO(), karmadaClient, []string{"member1"}, "", []BulkOperation{
+		{Op: BulkOperationAddTaint, Key: "k", Effect: "Sometimes"},
+	}); err == nil {
+		t.Errorf("BulkUpdateClusters() accepted an invalid taint effect")
+	}
+}
@@ -78,7 +78,7 @@ func toClusterList(_ karmadaclientset.Interface, clusters []v1alpha1.Cluster, no
 }
 
 func toCluster(cluster *v1alpha1.Cluster) Cluster {
-	allocatedResources, err := getclusterAllocatedResources(cluster)
+	allocatedResources, err := GetClusterAllocatedResources(cluster)
 	if err != nil {
 		log.Printf("Couldn't get allocated resources of %s cluster: %s\n", cluster.Name, err)
 	}
@@ -19,6 +19,7 @@ package cluster
 import (
 	"context"
 	"log"
+	"sort"
 
 	"github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
 	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
@@ -47,7 +48,8 @@ type ClusterAllocatedResources struct {
 	PodFraction float64 `json:"podFraction"`
 }
 
-func getclusterAllocatedResources(cluster *v1alpha1.Cluster) (ClusterAllocatedResources, error) {
+// GetClusterAllocatedResources computes the capacity and allocated fractions from the cluster resource summary.
+func GetClusterAllocatedResources(cluster *v1alpha1.Cluster) (ClusterAllocatedResources, error) {
 	if cluster.Status.ResourceSummary == nil {
 		return ClusterAllocatedResources{}, nil
 	}
@@ -90,6 +92,25 @@ func getclusterAllocatedResources(cluster *v1alpha1.Cluster) (ClusterAllocatedRe
 type ClusterDetail struct {
 	Cluster `json:",inline"`
 	Taints  []corev1.Taint `json:"taints,omitempty"`
+
+	ID          string   `json:"id,omitempty"`
+	APIEndpoint string   `json:"apiEndpoint,omitempty"`
+	Provider    string   `json:"provider,omitempty"`
+	Region      string   `json:"region,omitempty"`
+	Zones       []string `json:"zones,omitempty"`
+
+	InsecureSkipTLSVerification bool   `json:"insecureSkipTLSVerification"`
+	ProxyURL                    string `json:"proxyURL,omitempty"`
+	// ProxyHeaderKeys are the names of the proxy headers, their values may carry credentials and are left out.
+	ProxyHeaderKeys       []string                       `json:"proxyHeaderKeys,omitempty"`
+	SecretRef             *v1alpha1.LocalSecretReference `json:"secretRef,omitempty"`
+	ImpersonatorSecretRef *v1alpha1.LocalSecretReference `json:"impersonatorSecretRef,omitempty"`
+
+	// Conditions are all conditions of the cluster, with reasons, messages and last transition times.
+	Conditions      []metav1.Condition        `json:"conditions"`
+	APIEnablements  []v1alpha1.APIEnablement  `json:"apiEnablements"`
+	ResourceSummary *v1alpha1.ResourceSummary `json:"resourceSummary,omitempty"`
+	ResourceModels  []v1alpha1.ResourceModel  `json:"resourceModels,omitempty"`
 }
 
 // GetClusterDetail gets details of cluster.
@@ -99,8 +120,36 @@ func GetClusterDetail(client karmadaclientset.Interface, clusterName string) (*C
 	if err != nil {
 		return nil, err
 	}
-	return &ClusterDetail{
-		Cluster: toCluster(cluster),
-		Taints:  cluster.Spec.Taints,
-	}, nil
+	return toClusterDetail(cluster), nil
+}
+
+func toClusterDetail(cluster *v1alpha1.Cluster) *ClusterDetail {
+	detail := &ClusterDetail{
+		Cluster:                     toCluster(cluster),
+		Taints:                      cluster.Spec.Taints,
+		ID:                          cluster.Spec.ID,
+		APIEndpoint:                 cluster.Spec.APIEndpoint,
+		Provider:                    cluster.Spec.Provider,
+		Region:                      cluster.Spec.Region,
+		Zones:                       cluster.Spec.Zones,
+		InsecureSkipTLSVerification: cluster.Spec.InsecureSkipTLSVerification,
+		ProxyURL:                    cluster.Spec.ProxyURL,
+		SecretRef:                   cluster.Spec.SecretRef,
+		ImpersonatorSecretRef:       cluster.Spec.ImpersonatorSecretRef,
+		Conditions:                  cluster.Status.Conditions,
+		APIEnablements:              cluster.Status.APIEnablements,
+		ResourceSummary:             cluster.Status.ResourceSummary,
+		ResourceModels:              cluster.Spec.ResourceModels,
+	}
+	for key := range cluster.Spec.ProxyHeader {
+		detail.ProxyHeaderKeys = append(detail.ProxyHeaderKeys, key)
+	}
+	sort.Strings(detail.ProxyHeaderKeys)
+	if detail.Conditions == nil {
+		detail.Conditions = []metav1.Condition{}
+	}
+	if detail.APIEnablements == nil {
+		detail.APIEnablements = []v1alpha1.APIEnablement{}
+	}
+	return detail
 }
@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/kubernetes"
+)
+
+// probeTimeout bounds a single health probe of a member apiserver.
+const probeTimeout = 10 * time.Second
+
+// ClusterHealthProbe is the result of probing a member apiserver through the Karmada cluster proxy.
+type ClusterHealthProbe struct {
+	ProbeTime metav1.Time `json:"probeTime"`
+	Reachable bool        `json:"reachable"`
+	// Latency is the round trip of the /version request in milliseconds.
+	Latency int64 `json:"latency"`
+	// Version is the git version reported by the member apiserver.
+	Version string `json:"version,omitempty"`
+	// Ready is true if the member apiserver answered /readyz with ok.
+	Ready bool `json:"ready"`
+	// ReadyzOutput is the verbose /readyz output when the member apiserver is not ready.
+	ReadyzOutput string `json:"readyzOutput,omitempty"`
+	Error        string `json:"error,omitempty"`
+}
+
+// ProbeClusterHealth probes the member apiserver behind memberClient, which is expected to go through the Karmada
+// cluster proxy so that proxy and agent problems show up the same way they do for the control plane.
+func ProbeClusterHealth(ctx context.Context, memberClient kubernetes.Interface) *ClusterHealthProbe {
+	ctx, cancel := context.WithTimeout(ctx, probeTimeout)
+	defer cancel()
+
+	probe := &ClusterHealthProbe{ProbeTime: metav1.Now()}
+	restClient := memberClient.Discovery().RESTClient()
+
+	start := time.Now()
+	body, err := restClient.Get().AbsPath("/version").Do(ctx).Raw()
+	probe.Latency = time.Since(start).Milliseconds()
+	if err != nil {
+		probe.Error = err.Error()
+		return probe
+	}
+	probe.Reachable = true
+	var info version.Info
+	if err = json.Unmarshal(body, &info); err != nil {
+		probe.Error = err.Error()
+		return probe
+	}
+	probe.Version = info.GitVersion
+
+	body, err = restClient.Get().AbsPath("/readyz").Param("verbose", "true").Do(ctx).Raw()
+	if err != nil {
+		probe.Error = err.Error()
+		probe.ReadyzOutput = string(body)
+		return probe
+	}
+	probe.Ready = true
+	return probe
+}
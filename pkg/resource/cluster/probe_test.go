@@ -0,0 +1,69 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func TestProbeClusterHealth(t *testing.T) {
+	var unready atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/version":
+			_, _ = w.Write([]byte(`{"gitVersion":"v1.34.1"}`))
+		case "/readyz":
+			if unready.Load() {
+				w.WriteHeader(http.StatusInternalServerError)
+				_, _ = w.Write([]byte("[-]etcd failed"))
+				return
+			}
+			_, _ = w.Write([]byte("ok"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	memberClient, err := kubernetes.NewForConfig(&rest.Config{Host: server.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	probe := ProbeClusterHealth(context.TODO(), memberClient)
+	if !probe.Reachable || !probe.Ready || probe.Version != "v1.34.1" || probe.Error != "" {
+		t.Errorf("probe of a healthy apiserver = %+v", probe)
+	}
+
+	unready.Store(true)
+	probe = ProbeClusterHealth(context.TODO(), memberClient)
+	if !probe.Reachable || probe.Ready || probe.Error == "" {
+		t.Errorf("probe of an unready apiserver = %+v", probe)
+	}
+
+	server.Close()
+	probe = ProbeClusterHealth(context.TODO(), memberClient)
+	if probe.Reachable {
+		t.Errorf("probe of a stopped apiserver = %+v, want unreachable", probe)
+	}
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+
+	"github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"github.com/karmada-io/karmada/pkg/util/names"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EvictedWorkload is a resource template whose replicas leave a cluster when it is unjoined.
+type EvictedWorkload struct {
+	Resource         workv1alpha2.ObjectReference `json:"resource"`
+	BindingName      string                       `json:"bindingName"`
+	BindingNamespace string                       `json:"bindingNamespace,omitempty"`
+	// Replicas scheduled to the unjoined cluster.
+	Replicas int32 `json:"replicas"`
+	// OtherClusters are the remaining clusters the template is scheduled to, none means it runs nowhere afterwards.
+	OtherClusters []string `json:"otherClusters"`
+}
+
+// UnjoinPreview reports what unjoining a cluster affects, before anything is deleted.
+type UnjoinPreview struct {
+	ClusterName string                   `json:"clusterName"`
+	SyncMode    v1alpha1.ClusterSyncMode `json:"syncMode"`
+	Workloads   []EvictedWorkload        `json:"workloads"`
+	// WorkCount is the number of Works in the execution namespace of the cluster, removed along with it.
+	WorkCount int `json:"workCount"`
+	// SecretRefs are the credential secrets of the cluster in the control plane.
+	SecretRefs []v1alpha1.LocalSecretReference `json:"secretRefs"`
+}
+
+// GetUnjoinPreview lists the workloads scheduled to the cluster and the credentials left behind by unjoining it.
+func GetUnjoinPreview(ctx context.Context, client karmadaclientset.Interface, clusterName string) (*UnjoinPreview, error) {
+	cluster, err := client.ClusterV1alpha1().Clusters().Get(ctx, clusterName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	preview := &UnjoinPreview{
+		ClusterName: clusterName,
+		SyncMode:    cluster.Spec.SyncMode,
+		Workloads:   []EvictedWorkload{},
+		SecretRefs:  []v1alpha1.LocalSecretReference{},
+	}
+	for _, ref := range []*v1alpha1.LocalSecretReference{cluster.Spec.SecretRef, cluster.Spec.ImpersonatorSecretRef} {
+		if ref != nil {
+			preview.SecretRefs = append(preview.SecretRefs, *ref)
+		}
+	}
+
+	rbs, err := client.WorkV1alpha2().ResourceBindings(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, rb := range rbs.Items {
+		if workload, ok := evictedWorkload(&rb.Spec, clusterName); ok {
+			workload.BindingName, workload.BindingNamespace = rb.Name, rb.Namespace
+			preview.Workloads = append(preview.Workloads, workload)
+		}
+	}
+	crbs, err := client.WorkV1alpha2().ClusterResourceBindings().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, crb := range crbs.Items {
+		if workload, ok := evictedWorkload(&crb.Spec, clusterName); ok {
+			workload.BindingName = crb.Name
+			preview.Workloads = append(preview.Workloads, workload)
+		}
+	}
+
+	works, err := client.WorkV1alpha1().Works(names.GenerateExecutionSpaceName(clusterName)).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	preview.WorkCount = len(works.Items)
+	return preview, nil
+}
+
+func evictedWorkload(spec *workv1alpha2.ResourceBindingSpec, clusterName string) (EvictedWorkload, bool) {
+	workload := EvictedWorkload{Resource: spec.Resource, OtherClusters: []string{}}
+	found := false
+	for _, target := range spec.Clusters {
+		if target.Name == clusterName {
+			workload.Replicas = target.Replicas
+			found = true
+			continue
+		}
+		workload.OtherClusters = append(workload.OtherClusters, target.Name)
+	}
+	return workload, found
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadafake "github.com/karmada-io/karmada/pkg/generated/clientset/versioned/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGetUnjoinPreview(t *testing.T) {
+	karmadaClient := karmadafake.NewSimpleClientset(
+		&v1alpha1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "member1"},
+			Spec: v1alpha1.ClusterSpec{
+				SyncMode:  v1alpha1.Push,
+				SecretRef: &v1alpha1.LocalSecretReference{Namespace: "karmada-cluster", Name: "member1"},
+			},
+		},
+		&workv1alpha2.ResourceBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "nginx-deployment", Namespace: "default"},
+			Spec: workv1alpha2.ResourceBindingSpec{
+				Resource: workv1alpha2.ObjectReference{Kind: "Deployment", Namespace: "default", Name: "nginx"},
+				Clusters: []workv1alpha2.TargetCluster{{Name: "member1", Replicas: 2}, {Name: "member2", Replicas: 1}},
+			},
+		},
+		&workv1alpha2.ResourceBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "other-deployment", Namespace: "default"},
+			Spec: workv1alpha2.ResourceBindingSpec{
+				Clusters: []workv1alpha2.TargetCluster{{Name: "member2"}},
+			},
+		},
+		&workv1alpha2.ClusterResourceBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "ns-namespace"},
+			Spec: workv1alpha2.ResourceBindingSpec{
+				Resource: workv1alpha2.ObjectReference{Kind: "Namespace", Name: "ns"},
+				Clusters: []workv1alpha2.TargetCluster{{Name: "member1"}},
+			},
+		},
+		&workv1alpha1.Work{ObjectMeta: metav1.ObjectMeta{Name: "nginx", Namespace: "karmada-es-member1"}},
+	)
+
+	preview, err := GetUnjoinPreview(context.TODO(), karmadaClient, "member1")
+	if err != nil {
+		t.Fatalf("GetUnjoinPreview() error = %v", err)
+	}
+	want := []EvictedWorkload{
+		{
+			Resource:         workv1alpha2.ObjectReference{Kind: "Deployment", Namespace: "default", Name: "nginx"},
+			BindingName:      "nginx-deployment",
+			BindingNamespace: "default",
+			Replicas:         2,
+			OtherClusters:    []string{"member2"},
+		},
+		{
+			Resource:      workv1alpha2.ObjectReference{Kind: "Namespace", Name: "ns"},
+			BindingName:   "ns-namespace",
+			OtherClusters: []string{},
+		},
+	}
+	if !reflect.DeepEqual(preview.Workloads, want) {
+		t.Errorf("workloads = %+v, want %+v", preview.Workloads, want)
+	}
+	if preview.WorkCount != 1 || len(preview.SecretRefs) != 1 {
+		t.Errorf("workCount = %d, secretRefs = %v", preview.WorkCount, preview.SecretRefs)
+	}
+}
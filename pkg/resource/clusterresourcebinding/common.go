@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterresourcebinding
+
+import (
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+	"github.com/karmada-io/dashboard/pkg/resource/resourcebinding"
+)
+
+// ClusterResourceBindingCell is a wrapper around ClusterResourceBinding type
+type ClusterResourceBindingCell workv1alpha2.ClusterResourceBinding
+
+// GetProperty returns the given property of the ClusterResourceBinding.
+func (c ClusterResourceBindingCell) GetProperty(name dataselect.PropertyName) dataselect.ComparableValue {
+	switch name {
+	case dataselect.NameProperty:
+		return dataselect.StdComparableString(c.ObjectMeta.Name)
+	case dataselect.CreationTimestampProperty:
+		return dataselect.StdComparableTime(c.ObjectMeta.CreationTimestamp.Time)
+	case dataselect.ClusterProperty:
+		return dataselect.StdComparableStringSlice(resourcebinding.TargetClusterNames(c.Spec.Clusters))
+	case dataselect.ScheduledProperty:
+		return dataselect.StdComparableString(resourcebinding.ConditionStatus(c.Status.Conditions, workv1alpha2.Scheduled))
+	case dataselect.FullyAppliedProperty:
+		return dataselect.StdComparableString(resourcebinding.ConditionStatus(c.Status.Conditions, workv1alpha2.FullyApplied))
+	case dataselect.ResourceKindProperty:
+		return dataselect.StdComparableString(c.Spec.Resource.Kind)
+	case dataselect.ResourceNameProperty:
+		return dataselect.StdComparableString(c.Spec.Resource.Name)
+	default:
+		// if name is not supported then just return a constant dummy value, sort will have no effect.
+		return nil
+	}
+}
+
+func toCells(std []workv1alpha2.ClusterResourceBinding) []dataselect.DataCell {
+	cells := make([]dataselect.DataCell, len(std))
+	for i := range std {
+		cells[i] = ClusterResourceBindingCell(std[i])
+	}
+	return cells
+}
+
+func fromCells(cells []dataselect.DataCell) []workv1alpha2.ClusterResourceBinding {
+	std := make([]workv1alpha2.ClusterResourceBinding, len(cells))
+	for i := range std {
+		std[i] = workv1alpha2.ClusterResourceBinding(cells[i].(ClusterResourceBindingCell))
+	}
+	return std
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterresourcebinding
+
+import (
+	"context"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+)
+
+// ClusterResourceBindingDetail is a presentation layer view of Karmada ClusterResourceBinding resource. Besides the list item it
+// carries the scheduling input and the per-cluster status, including apply errors.
+type ClusterResourceBindingDetail struct {
+	// Extends list item structure.
+	ClusterResourceBinding `json:",inline"`
+
+	Spec   workv1alpha2.ResourceBindingSpec   `json:"spec"`
+	Status workv1alpha2.ResourceBindingStatus `json:"status"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// GetClusterResourceBindingDetail gets ClusterResourceBinding details.
+func GetClusterResourceBindingDetail(client karmadaclientset.Interface, name string) (*ClusterResourceBindingDetail, error) {
+	clusterResourceBindingData, err := client.WorkV1alpha2().ClusterResourceBindings().Get(context.TODO(), name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	clusterResourceBinding := toClusterResourceBindingDetail(clusterResourceBindingData, nonCriticalErrors)
+	return &clusterResourceBinding, nil
+}
+
+func toClusterResourceBindingDetail(clusterResourceBinding *workv1alpha2.ClusterResourceBinding, nonCriticalErrors []error) ClusterResourceBindingDetail {
+	return ClusterResourceBindingDetail{
+		ClusterResourceBinding: toClusterResourceBinding(clusterResourceBinding),
+		Spec:                   clusterResourceBinding.Spec,
+		Status:                 clusterResourceBinding.Status,
+		Errors:                 nonCriticalErrors,
+	}
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterresourcebinding
+
+import (
+	"context"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/common/helpers"
+	"github.com/karmada-io/dashboard/pkg/common/types"
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+	"github.com/karmada-io/dashboard/pkg/resource/resourcebinding"
+)
+
+// ClusterResourceBindingList contains a list of ClusterResourceBindings in the karmada control-plane.
+type ClusterResourceBindingList struct {
+	ListMeta types.ListMeta `json:"listMeta"`
+
+	// Unordered list of ClusterResourceBindings.
+	ClusterResourceBindings []ClusterResourceBinding `json:"clusterResourceBindings"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// ClusterResourceBinding contains information about a single ClusterResourceBinding.
+type ClusterResourceBinding struct {
+	ObjectMeta types.ObjectMeta `json:"objectMeta"`
+	TypeMeta   types.TypeMeta   `json:"typeMeta"`
+	// Resource is the resource template the binding was created for.
+	Resource     workv1alpha2.ObjectReference `json:"resource"`
+	Replicas     int32                        `json:"replicas"`
+	Clusters     []workv1alpha2.TargetCluster `json:"clusters"`
+	Scheduled    metav1.ConditionStatus       `json:"scheduled"`
+	FullyApplied metav1.ConditionStatus       `json:"fullyApplied"`
+}
+
+// GetClusterResourceBindingList returns a list of all ClusterResourceBindings in the karmada control-plane.
+// Besides the usual properties, dsQuery can filter on cluster, scheduled, fullyApplied, resourceKind and resourceName.
+func GetClusterResourceBindingList(client karmadaclientset.Interface, dsQuery *dataselect.DataSelectQuery) (*ClusterResourceBindingList, error) {
+	clusterResourceBindings, err := client.WorkV1alpha2().ClusterResourceBindings().List(context.TODO(), helpers.ListEverything)
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	return toClusterResourceBindingList(clusterResourceBindings.Items, nonCriticalErrors, dsQuery), nil
+}
+
+func toClusterResourceBindingList(clusterResourceBindings []workv1alpha2.ClusterResourceBinding, nonCriticalErrors []error, dsQuery *dataselect.DataSelectQuery) *ClusterResourceBindingList {
+	clusterResourceBindingList := &ClusterResourceBindingList{
+		ClusterResourceBindings: make([]ClusterResourceBinding, 0),
+		ListMeta:                types.ListMeta{TotalItems: len(clusterResourceBindings)},
+	}
+	clusterResourceBindingCells, filteredTotal := dataselect.GenericDataSelectWithFilter(toCells(clusterResourceBindings), dsQuery)
+	clusterResourceBindings = fromCells(clusterResourceBindingCells)
+	clusterResourceBindingList.ListMeta = types.ListMeta{TotalItems: filteredTotal}
+	clusterResourceBindingList.Errors = nonCriticalErrors
+
+	for i := range clusterResourceBindings {
+		clusterResourceBindingList.ClusterResourceBindings = append(clusterResourceBindingList.ClusterResourceBindings, toClusterResourceBinding(&clusterResourceBindings[i]))
+	}
+	return clusterResourceBindingList
+}
+
+func toClusterResourceBinding(clusterResourceBinding *workv1alpha2.ClusterResourceBinding) ClusterResourceBinding {
+	return ClusterResourceBinding{
+		ObjectMeta:   types.NewObjectMeta(clusterResourceBinding.ObjectMeta),
+		TypeMeta:     types.NewTypeMeta(types.ResourceKindClusterResourceBinding),
+		Resource:     clusterResourceBinding.Spec.Resource,
+		Replicas:     clusterResourceBinding.Spec.Replicas,
+		Clusters:     clusterResourceBinding.Spec.Clusters,
+		Scheduled:    resourcebinding.ConditionStatus(clusterResourceBinding.Status.Conditions, workv1alpha2.Scheduled),
+		FullyApplied: resourcebinding.ConditionStatus(clusterResourceBinding.Status.Conditions, workv1alpha2.FullyApplied),
+	}
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"encoding/json"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"sigs.k8s.io/yaml"
+)
+
+// DryRunResult is returned instead of the usual response when a write runs with DryRun=All. Object is what the
+// apiserver would persist, including admission webhook mutations, and Diff a unified diff of it against the live
+// object. Diff is against an empty document when the object does not exist yet.
+type DryRunResult struct {
+	Object interface{} `json:"object"`
+	Diff   string      `json:"diff"`
+}
+
+// NewDryRunResult diffs the dry-run result of a write against the live object, live may be nil.
+func NewDryRunResult(live, object interface{}) (*DryRunResult, error) {
+	before, err := diffableYAML(live)
+	if err != nil {
+		return nil, err
+	}
+	after, err := diffableYAML(object)
+	if err != nil {
+		return nil, err
+	}
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(before),
+		B:        difflib.SplitLines(after),
+		FromFile: "live",
+		ToFile:   "dry-run",
+		Context:  3,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &DryRunResult{Object: object, Diff: diff}, nil
+}
+
+// diffableYAML renders obj as YAML without managedFields, which change on every write and only add noise.
+// A nil obj, including a typed nil pointer, renders as an empty document.
+func diffableYAML(obj interface{}) (string, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return "", err
+	}
+	var content map[string]interface{}
+	if err = json.Unmarshal(data, &content); err != nil {
+		return "", err
+	}
+	if content == nil {
+		return "", nil
+	}
+	if metadata, ok := content["metadata"].(map[string]interface{}); ok {
+		delete(metadata, "managedFields")
+	}
+	out, err := yaml.Marshal(content)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
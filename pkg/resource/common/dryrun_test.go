@@ -0,0 +1,64 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNewDryRunResult(t *testing.T) {
+	live := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:          "settings",
+			ManagedFields: []metav1.ManagedFieldsEntry{{Manager: "kubectl"}},
+		},
+		Data: map[string]string{"mode": "a"},
+	}
+	persisted := live.DeepCopy()
+	persisted.ManagedFields = []metav1.ManagedFieldsEntry{{Manager: "karmada-dashboard"}}
+	persisted.Data["mode"] = "b"
+	persisted.Labels = map[string]string{"injected-by": "webhook"}
+
+	result, err := NewDryRunResult(live, persisted)
+	if err != nil {
+		t.Fatalf("NewDryRunResult() error = %v", err)
+	}
+	if result.Object != persisted {
+		t.Errorf("result object is not the dry-run object")
+	}
+	for _, want := range []string{"-  mode: a", "+  mode: b", "+    injected-by: webhook"} {
+		if !strings.Contains(result.Diff, want) {
+			t.Errorf("diff does not contain %q:\n%s", want, result.Diff)
+		}
+	}
+	if strings.Contains(result.Diff, "manager") {
+		t.Errorf("diff contains managedFields:\n%s", result.Diff)
+	}
+
+	var missing *corev1.ConfigMap
+	result, err = NewDryRunResult(missing, persisted)
+	if err != nil {
+		t.Fatalf("NewDryRunResult() without a live object error = %v", err)
+	}
+	if !strings.Contains(result.Diff, "+  name: settings") || strings.Contains(result.Diff, "\n-") {
+		t.Errorf("diff against a missing object should only add lines:\n%s", result.Diff)
+	}
+}
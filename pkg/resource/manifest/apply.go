@@ -0,0 +1,338 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package manifest applies multi-document manifests to the Karmada control plane.
+package manifest
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/karmada-io/dashboard/pkg/client"
+)
+
+// ApplyStatus is the outcome of applying one object.
+type ApplyStatus string
+
+const (
+	// ApplyCreated means the object did not exist before.
+	ApplyCreated ApplyStatus = "created"
+	// ApplyConfigured means the object existed and was changed.
+	ApplyConfigured ApplyStatus = "configured"
+	// ApplyUnchanged means the object existed and the apply changed nothing.
+	ApplyUnchanged ApplyStatus = "unchanged"
+	// ApplyFailed means the object could not be applied, see ApplyOutcome.Error.
+	ApplyFailed ApplyStatus = "failed"
+)
+
+// ApplyOutcome reports what happened to one object of the manifests.
+type ApplyOutcome struct {
+	APIVersion string      `json:"apiVersion"`
+	Kind       string      `json:"kind"`
+	Namespace  string      `json:"namespace,omitempty"`
+	Name       string      `json:"name"`
+	Status     ApplyStatus `json:"status"`
+	Error      string      `json:"error,omitempty"`
+}
+
+// ApplyResult lists the outcome of every object in apply order.
+type ApplyResult struct {
+	Outcomes  []ApplyOutcome `json:"outcomes"`
+	Succeeded int            `json:"succeeded"`
+	Failed    int            `json:"failed"`
+}
+
+// PropagationPolicyOptions asks for a PropagationPolicy that propagates the applied objects.
+type PropagationPolicyOptions struct {
+	// Name of the PropagationPolicy, one is created in every namespace objects were applied to.
+	Name string `json:"name" binding:"required"`
+	// ClusterNames the objects are propagated to.
+	ClusterNames []string `json:"clusterNames" binding:"required"`
+}
+
+// ApplyOptions tunes ApplyManifests.
+type ApplyOptions struct {
+	// DefaultNamespace is used for namespaced objects that do not set one.
+	DefaultNamespace string
+	// ForceConflicts takes over fields owned by other field managers.
+	ForceConflicts bool
+	// DryRun is passed on as the DryRun patch option.
+	DryRun []string
+	// PropagationPolicy, if set, is applied after the objects.
+	PropagationPolicy *PropagationPolicyOptions
+}
+
+// applyOrder ranks kinds so that objects are applied after the ones they depend on.
+// Kinds missing from the list are applied last, in manifest order.
+var applyOrder = map[string]int{
+	"Namespace":                0,
+	"CustomResourceDefinition": 1,
+	"ResourceQuota":            2,
+	"LimitRange":               2,
+	"PriorityClass":            2,
+	"StorageClass":             2,
+	"ServiceAccount":           3,
+	"Secret":                   4,
+	"ConfigMap":                4,
+	"PersistentVolume":         5,
+	"PersistentVolumeClaim":    6,
+	"ClusterRole":              7,
+	"ClusterRoleBinding":       8,
+	"Role":                     7,
+	"RoleBinding":              8,
+	"Service":                  9,
+	"DaemonSet":                10,
+	"Pod":                      10,
+	"ReplicaSet":               10,
+	"Deployment":               10,
+	"StatefulSet":              10,
+	"Job":                      10,
+	"CronJob":                  10,
+	"HorizontalPodAutoscaler":  11,
+	"Ingress":                  11,
+}
+
+// ParseManifests decodes multi-document YAML or JSON into objects. List kinds, such as the output of
+// kubectl get -o yaml, are expanded into their items and empty documents are skipped.
+func ParseManifests(data []byte) ([]*unstructured.Unstructured, error) {
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(data)))
+	var objects []*unstructured.Unstructured
+	for i := 0; ; i++ {
+		document, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return objects, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("document %d: %w", i, err)
+		}
+		content, err := utilyaml.ToJSON(document)
+		if err != nil {
+			return nil, fmt.Errorf("document %d: %w", i, err)
+		}
+		if content = bytes.TrimSpace(content); len(content) == 0 || bytes.Equal(content, []byte("null")) {
+			continue
+		}
+		obj := &unstructured.Unstructured{}
+		if err = obj.UnmarshalJSON(content); err != nil {
+			return nil, fmt.Errorf("document %d: %w", i, err)
+		}
+		if obj.IsList() {
+			list, err := obj.ToList()
+			if err != nil {
+				return nil, fmt.Errorf("document %d: %w", i, err)
+			}
+			for j := range list.Items {
+				objects = append(objects, &list.Items[j])
+			}
+			continue
+		}
+		if obj.GetName() == "" {
+			return nil, fmt.Errorf("document %d: metadata.name is required", i)
+		}
+		objects = append(objects, obj)
+	}
+}
+
+// SortForApply orders objects by applyOrder, keeping the manifest order within a rank.
+func SortForApply(objects []*unstructured.Unstructured) {
+	rank := func(obj *unstructured.Unstructured) int {
+		if r, ok := applyOrder[obj.GetKind()]; ok {
+			return r
+		}
+		return len(applyOrder)
+	}
+	sort.SliceStable(objects, func(i, j int) bool {
+		return rank(objects[i]) < rank(objects[j])
+	})
+}
+
+// ApplyManifests server-side applies objects as client.FieldManager, in SortForApply order. GVRs are resolved
+// through mapper, which is reset once when a kind is unknown so CRDs applied earlier in the same call are found.
+// Failures are reported per object and do not stop the remaining objects from being applied.
+func ApplyManifests(ctx context.Context, dynamicClient dynamic.Interface, mapper meta.ResettableRESTMapper,
+	objects []*unstructured.Unstructured, opts ApplyOptions) *ApplyResult {
+	SortForApply(objects)
+	result := &ApplyResult{Outcomes: make([]ApplyOutcome, 0, len(objects))}
+	namespacedObjects := map[string][]*unstructured.Unstructured{}
+	var namespaces []string
+	for _, obj := range objects {
+		outcome := applyObject(ctx, dynamicClient, mapper, obj, opts)
+		result.add(outcome)
+		if outcome.Status == ApplyFailed || outcome.Namespace == "" || isPolicy(obj) {
+			continue
+		}
+		if _, ok := namespacedObjects[outcome.Namespace]; !ok {
+			namespaces = append(namespaces, outcome.Namespace)
+		}
+		namespacedObjects[outcome.Namespace] = append(namespacedObjects[outcome.Namespace], obj)
+	}
+
+	if opts.PropagationPolicy == nil {
+		return result
+	}
+	for _, namespace := range namespaces {
+		policy, err := buildPropagationPolicy(opts.PropagationPolicy, namespace, namespacedObjects[namespace])
+		if err != nil {
+			result.add(ApplyOutcome{
+				APIVersion: policyv1alpha1.SchemeGroupVersion.String(),
+				Kind:       policyv1alpha1.ResourceKindPropagationPolicy,
+				Namespace:  namespace,
+				Name:       opts.PropagationPolicy.Name,
+				Status:     ApplyFailed,
+				Error:      err.Error(),
+			})
+			continue
+		}
+		result.add(applyObject(ctx, dynamicClient, mapper, policy, opts))
+	}
+	return result
+}
+
+func (r *ApplyResult) add(outcome ApplyOutcome) {
+	r.Outcomes = append(r.Outcomes, outcome)
+	if outcome.Status == ApplyFailed {
+		r.Failed++
+	} else {
+		r.Succeeded++
+	}
+}
+
+func isPolicy(obj *unstructured.Unstructured) bool {
+	return obj.GroupVersionKind().Group == policyv1alpha1.GroupName
+}
+
+// applyObject server-side applies a single object. The object is read before the apply to tell a
+// creation from a change, and the namespace of namespaced objects is defaulted.
+func applyObject(ctx context.Context, dynamicClient dynamic.Interface, mapper meta.ResettableRESTMapper,
+	obj *unstructured.Unstructured, opts ApplyOptions) ApplyOutcome {
+	outcome := ApplyOutcome{
+		APIVersion: obj.GetAPIVersion(),
+		Kind:       obj.GetKind(),
+		Name:       obj.GetName(),
+		Status:     ApplyFailed,
+	}
+	gvk := obj.GroupVersionKind()
+	mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if meta.IsNoMatchError(err) {
+		mapper.Reset()
+		mapping, err = mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	}
+	if err != nil {
+		outcome.Error = err.Error()
+		return outcome
+	}
+
+	resource := dynamicClient.Resource(mapping.Resource)
+	var target dynamic.ResourceInterface = resource
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		if obj.GetNamespace() == "" {
+			obj.SetNamespace(opts.DefaultNamespace)
+		}
+		outcome.Namespace = obj.GetNamespace()
+		target = resource.Namespace(obj.GetNamespace())
+	} else {
+		obj.SetNamespace("")
+	}
+
+	existing, err := target.Get(ctx, obj.GetName(), metav1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		outcome.Error = err.Error()
+		return outcome
+	}
+	exists := err == nil
+
+	data, err := json.Marshal(obj)
+	if err != nil {
+		outcome.Error = err.Error()
+		return outcome
+	}
+	applied, err := target.Patch(ctx, obj.GetName(), types.ApplyPatchType, data, metav1.PatchOptions{
+		FieldManager: client.FieldManager,
+		Force:        &opts.ForceConflicts,
+		DryRun:       opts.DryRun,
+	})
+	if err != nil {
+		outcome.Error = err.Error()
+		return outcome
+	}
+
+	switch {
+	case !exists:
+		outcome.Status = ApplyCreated
+	case equality.Semantic.DeepEqual(comparableContent(existing), comparableContent(applied)):
+		outcome.Status = ApplyUnchanged
+	default:
+		outcome.Status = ApplyConfigured
+	}
+	return outcome
+}
+
+// comparableContent returns the content of obj without status and the metadata the apiserver bumps on
+// every write. A dry-run apply never persists, so its resourceVersion can't tell whether anything changed.
+func comparableContent(obj *unstructured.Unstructured) map[string]interface{} {
+	content := obj.DeepCopy().Object
+	delete(content, "status")
+	for _, field := range []string{"resourceVersion", "generation", "managedFields"} {
+		unstructured.RemoveNestedField(content, "metadata", field)
+	}
+	return content
+}
+
+// buildPropagationPolicy returns a PropagationPolicy in namespace that selects each of objects by name.
+func buildPropagationPolicy(opts *PropagationPolicyOptions, namespace string, objects []*unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	policy := &policyv1alpha1.PropagationPolicy{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: policyv1alpha1.SchemeGroupVersion.String(),
+			Kind:       policyv1alpha1.ResourceKindPropagationPolicy,
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: opts.Name, Namespace: namespace},
+		Spec: policyv1alpha1.PropagationSpec{
+			Placement: policyv1alpha1.Placement{
+				ClusterAffinity: &policyv1alpha1.ClusterAffinity{ClusterNames: opts.ClusterNames},
+			},
+		},
+	}
+	for _, obj := range objects {
+		policy.Spec.ResourceSelectors = append(policy.Spec.ResourceSelectors, policyv1alpha1.ResourceSelector{
+			APIVersion: obj.GetAPIVersion(),
+			Kind:       obj.GetKind(),
+			Namespace:  namespace,
+			Name:       obj.GetName(),
+		})
+	}
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(policy)
+	if err != nil {
+		return nil, err
+	}
+	return &unstructured.Unstructured{Object: content}, nil
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"reflect"
+	"testing"
+
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const manifests = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+spec:
+  replicas: 1000000
+---
+# only a comment
+---
+apiVersion: v1
+kind: List
+items:
+- apiVersion: v1
+  kind: ConfigMap
+  metadata:
+    name: web-config
+- apiVersion: v1
+  kind: Service
+  metadata:
+    name: web
+---
+{"apiVersion": "v1", "kind": "Namespace", "metadata": {"name": "shop"}}
+---
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: carts.shop.example.io
+---
+apiVersion: shop.example.io/v1
+kind: Cart
+metadata:
+  name: default
+`
+
+func kindsAndNames(objects []*unstructured.Unstructured) []string {
+	var got []string
+	for _, obj := range objects {
+		got = append(got, obj.GetKind()+"/"+obj.GetName())
+	}
+	return got
+}
+
+func TestParseAndSortManifests(t *testing.T) {
+	objects, err := ParseManifests([]byte(manifests))
+	if err != nil {
+		t.Fatalf("ParseManifests() error = %v", err)
+	}
+	want := []string{"Deployment/web", "ConfigMap/web-config", "Service/web", "Namespace/shop", "CustomResourceDefinition/carts.shop.example.io", "Cart/default"}
+	if got := kindsAndNames(objects); !reflect.DeepEqual(got, want) {
+		t.Errorf("parsed objects = %v, want %v", got, want)
+	}
+	if replicas, _, _ := unstructured.NestedInt64(objects[0].Object, "spec", "replicas"); replicas != 1000000 {
+		t.Errorf("replicas = %d, want the integer kept", replicas)
+	}
+
+	SortForApply(objects)
+	want = []string{"Namespace/shop", "CustomResourceDefinition/carts.shop.example.io", "ConfigMap/web-config", "Service/web", "Deployment/web", "Cart/default"}
+	if got := kindsAndNames(objects); !reflect.DeepEqual(got, want) {
+		t.Errorf("apply order = %v, want %v", got, want)
+	}
+
+	if _, err = ParseManifests([]byte("apiVersion: v1\nkind: ConfigMap\n")); err == nil {
+		t.Errorf("expected an error for an object without a name")
+	}
+}
+
+func TestBuildPropagationPolicy(t *testing.T) {
+	objects, err := ParseManifests([]byte(manifests))
+	if err != nil {
+		t.Fatalf("ParseManifests() error = %v", err)
+	}
+	content, err := buildPropagationPolicy(&PropagationPolicyOptions{Name: "web", ClusterNames: []string{"member1"}}, "shop", objects[:2])
+	if err != nil {
+		t.Fatalf("buildPropagationPolicy() error = %v", err)
+	}
+	policy := &policyv1alpha1.PropagationPolicy{}
+	if err = runtime.DefaultUnstructuredConverter.FromUnstructured(content.Object, policy); err != nil {
+		t.Fatalf("convert policy: %v", err)
+	}
+	if policy.Namespace != "shop" || policy.Name != "web" || content.GetKind() != "PropagationPolicy" {
+		t.Errorf("policy = %s %s/%s, want PropagationPolicy shop/web", content.GetKind(), policy.Namespace, policy.Name)
+	}
+	wantSelectors := []policyv1alpha1.ResourceSelector{
+		{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "shop", Name: "web"},
+		{APIVersion: "v1", Kind: "ConfigMap", Namespace: "shop", Name: "web-config"},
+	}
+	if !reflect.DeepEqual(policy.Spec.ResourceSelectors, wantSelectors) {
+		t.Errorf("resource selectors = %v, want %v", policy.Spec.ResourceSelectors, wantSelectors)
+	}
+	if got := policy.Spec.Placement.ClusterAffinity.ClusterNames; !reflect.DeepEqual(got, []string{"member1"}) {
+		t.Errorf("cluster names = %v, want [member1]", got)
+	}
+}
+
+func TestComparableContent(t *testing.T) {
+	existing := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"name":            "web-config",
+			"resourceVersion": "1",
+			"generation":      int64(1),
+			"managedFields":   []interface{}{map[string]interface{}{"manager": "kubectl"}},
+		},
+		"data": map[string]interface{}{"mode": "a"},
+	}}
+	applied := existing.DeepCopy()
+	applied.SetResourceVersion("2")
+	applied.SetGeneration(2)
+	applied.SetManagedFields(nil)
+	applied.Object["status"] = map[string]interface{}{"observed": true}
+	if !reflect.DeepEqual(comparableContent(existing), comparableContent(applied)) {
+		t.Errorf("objects differing only in bookkeeping fields compare as changed")
+	}
+	if existing.GetResourceVersion() != "1" {
+		t.Errorf("comparableContent() modified its argument")
+	}
+
+	if err := unstructured.SetNestedField(applied.Object, "b", "data", "mode"); err != nil {
+		t.Fatal(err)
+	}
+	if reflect.DeepEqual(comparableContent(existing), comparableContent(applied)) {
+		t.Errorf("objects with different data compare as unchanged")
+	}
+}
@@ -39,8 +39,8 @@ type NamespaceSpec struct {
 	SkipAutoPropagation bool
 }
 
-// CreateNamespace creates namespace based on given specification.
-func CreateNamespace(spec *NamespaceSpec, client kubernetes.Interface) error {
+// CreateNamespace creates namespace based on given specification. dryRun is passed on as the DryRun create option.
+func CreateNamespace(spec *NamespaceSpec, client kubernetes.Interface, dryRun []string) (*api.Namespace, error) {
 	// todo add namespace.karmada.io/skip-auto-propagation: "true"  to avoid auto-propagation
 	// https://karmada.io/docs/userguide/bestpractices/namespace-management/#labeling-the-namespace
 	log.Printf("Creating namespace %s", spec.Name)
@@ -54,8 +54,7 @@ func CreateNamespace(spec *NamespaceSpec, client kubernetes.Interface) error {
 			skipAutoPropagationLable: "true",
 		}
 	}
-	_, err := client.CoreV1().Namespaces().Create(context.TODO(), namespace, metaV1.CreateOptions{})
-	return err
+	return client.CoreV1().Namespaces().Create(context.TODO(), namespace, metaV1.CreateOptions{DryRun: dryRun})
 }
 
 // The code below allows to perform complex data section on []api.Namespace
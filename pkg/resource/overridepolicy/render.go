@@ -0,0 +1,264 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package overridepolicy
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	karmadascheme "github.com/karmada-io/karmada/pkg/generated/clientset/versioned/scheme"
+	karmadautil "github.com/karmada-io/karmada/pkg/util"
+	"github.com/karmada-io/karmada/pkg/util/names"
+	"github.com/karmada-io/karmada/pkg/util/overridemanager"
+	"github.com/pmezard/go-difflib/difflib"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/restmapper"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+
+	"github.com/karmada-io/dashboard/pkg/resource/topology"
+)
+
+// RenderedOverride is a single override rule applied to the resource template, in application order.
+type RenderedOverride struct {
+	Policy topology.OverridePolicyRef `json:"policy"`
+	// RuleIndex is the index of the rule in the policy's OverrideRules, -1 for the deprecated
+	// TargetCluster/Overriders fields.
+	RuleIndex int `json:"ruleIndex"`
+	// ImplicitPriority is how specifically the policy's resource selectors match the template.
+	ImplicitPriority int                       `json:"implicitPriority"`
+	Overriders       policyv1alpha1.Overriders `json:"overriders"`
+	// Diff is a unified diff of the manifest before and after the rule, empty if the rule changed nothing.
+	Diff string `json:"diff"`
+}
+
+// OverrideRendering is the manifest a resource template ends up with in a member cluster once
+// every matching ClusterOverridePolicy and OverridePolicy has been applied.
+type OverrideRendering struct {
+	Cluster   string                     `json:"cluster"`
+	Manifest  *unstructured.Unstructured `json:"manifest"`
+	Overrides []RenderedOverride         `json:"overrides"`
+	// AppliedOverrides are the policies Karmada recorded on the Work for this cluster,
+	// empty if the template has not been propagated to the cluster yet.
+	AppliedOverrides []topology.OverridePolicyRef `json:"appliedOverrides"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// overrideRule is a rule of a policy that matches both the resource template and the cluster.
+type overrideRule struct {
+	policy           topology.OverridePolicyRef
+	ruleIndex        int
+	implicitPriority karmadautil.ImplicitPriority
+	overriders       policyv1alpha1.Overriders
+}
+
+// GetResourceTemplate fetches the resource template identified by apiVersion, kind, namespace and name
+// from the control plane. namespace is ignored for cluster-scoped kinds.
+func GetResourceTemplate(ctx context.Context, discoveryClient discovery.DiscoveryInterface, dynamicClient dynamic.Interface,
+	apiVersion, kind, namespace, name string) (*unstructured.Unstructured, error) {
+	gv, err := schema.ParseGroupVersion(apiVersion)
+	if err != nil {
+		return nil, err
+	}
+	mapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(discoveryClient))
+	mapping, err := mapper.RESTMapping(schema.GroupKind{Group: gv.Group, Kind: kind}, gv.Version)
+	if err != nil {
+		return nil, fmt.Errorf("resolve %s %s: %w", apiVersion, kind, err)
+	}
+	if mapping.Scope.Name() == meta.RESTScopeNameRoot {
+		namespace = ""
+	}
+	return dynamicClient.Resource(mapping.Resource).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+}
+
+// RenderOverridePolicies applies the override policies matching template and clusterName in the order
+// Karmada does: ClusterOverridePolicies first, then the OverridePolicies of the template's namespace.
+// Within each scope policies are ordered by ascending implicit priority, then by name.
+func RenderOverridePolicies(ctx context.Context, karmadaClient karmadaclientset.Interface,
+	template *unstructured.Unstructured, clusterName string) (*OverrideRendering, error) {
+	cluster, err := karmadaClient.ClusterV1alpha1().Clusters().Get(ctx, clusterName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	cops, err := karmadaClient.PolicyV1alpha1().ClusterOverridePolicies().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	clusterPolicies := make([]overridemanager.GeneralOverridePolicy, 0, len(cops.Items))
+	for i := range cops.Items {
+		clusterPolicies = append(clusterPolicies, &cops.Items[i])
+	}
+	rules := matchingRules(clusterPolicies, true, template, cluster)
+	if template.GetNamespace() != "" {
+		ops, err := karmadaClient.PolicyV1alpha1().OverridePolicies(template.GetNamespace()).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		policies := make([]overridemanager.GeneralOverridePolicy, 0, len(ops.Items))
+		for i := range ops.Items {
+			policies = append(policies, &ops.Items[i])
+		}
+		rules = append(rules, matchingRules(policies, false, template, cluster)...)
+	}
+
+	rendering := &OverrideRendering{
+		Cluster:          clusterName,
+		Overrides:        make([]RenderedOverride, 0, len(rules)),
+		AppliedOverrides: []topology.OverridePolicyRef{},
+		Errors:           []error{},
+	}
+	manifest := template.DeepCopy()
+	// Server-populated fields never reach the member cluster and only add noise to the diffs.
+	unstructured.RemoveNestedField(manifest.Object, "metadata", "managedFields")
+	unstructured.RemoveNestedField(manifest.Object, "status")
+	for _, rule := range rules {
+		before, err := yaml.Marshal(manifest.Object)
+		if err != nil {
+			return nil, err
+		}
+		if err = applyOverriders(manifest, cluster, rule.overriders); err != nil {
+			return nil, fmt.Errorf("apply %s: %w", describePolicy(rule.policy), err)
+		}
+		after, err := yaml.Marshal(manifest.Object)
+		if err != nil {
+			return nil, err
+		}
+		diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        difflib.SplitLines(string(before)),
+			B:        difflib.SplitLines(string(after)),
+			FromFile: "before",
+			ToFile:   describePolicy(rule.policy),
+			Context:  3,
+		})
+		if err != nil {
+			return nil, err
+		}
+		rendering.Overrides = append(rendering.Overrides, RenderedOverride{
+			Policy:           rule.policy,
+			RuleIndex:        rule.ruleIndex,
+			ImplicitPriority: int(rule.implicitPriority),
+			Overriders:       rule.overriders,
+			Diff:             diff,
+		})
+	}
+	rendering.Manifest = manifest
+
+	workName := names.GenerateWorkName(template.GetKind(), template.GetName(), template.GetNamespace())
+	work, err := karmadaClient.WorkV1alpha1().Works(names.GenerateExecutionSpaceName(clusterName)).Get(ctx, workName, metav1.GetOptions{})
+	switch {
+	case err == nil:
+		rendering.AppliedOverrides = append(rendering.AppliedOverrides,
+			topology.ParseOverridePolicies(work.Annotations, template.GetNamespace())...)
+	case !apierrors.IsNotFound(err):
+		rendering.Errors = append(rendering.Errors, err)
+	}
+	return rendering, nil
+}
+
+// matchingRules mirrors the override manager's selection: policies matching the template are sorted by
+// implicit priority and name, then every rule whose target cluster matches is kept.
+func matchingRules(policies []overridemanager.GeneralOverridePolicy, isClusterScope bool,
+	template *unstructured.Unstructured, cluster *clusterv1alpha1.Cluster) []overrideRule {
+	type matchedPolicy struct {
+		policy   overridemanager.GeneralOverridePolicy
+		priority karmadautil.ImplicitPriority
+	}
+	var matched []matchedPolicy
+	for _, policy := range policies {
+		selectors := policy.GetOverrideSpec().ResourceSelectors
+		if len(selectors) == 0 {
+			matched = append(matched, matchedPolicy{policy: policy, priority: karmadautil.PriorityMatchAll})
+			continue
+		}
+		if karmadautil.ResourceMatchSelectors(template, selectors...) {
+			matched = append(matched, matchedPolicy{
+				policy:   policy,
+				priority: karmadautil.ResourceMatchSelectorsPriority(template, selectors...),
+			})
+		}
+	}
+	sort.SliceStable(matched, func(i, j int) bool {
+		if matched[i].priority != matched[j].priority {
+			return matched[i].priority < matched[j].priority
+		}
+		return matched[i].policy.GetName() < matched[j].policy.GetName()
+	})
+
+	var rules []overrideRule
+	for _, m := range matched {
+		spec := m.policy.GetOverrideSpec()
+		ref := topology.OverridePolicyRef{Name: m.policy.GetName(), IsClusterScope: isClusterScope}
+		if !isClusterScope {
+			ref.Namespace = m.policy.GetNamespace()
+		}
+		overrideRules := spec.OverrideRules
+		firstIndex := 0
+		if len(overrideRules) == 0 {
+			//nolint:staticcheck
+			// disable `deprecation` check for backward compatibility.
+			overrideRules = []policyv1alpha1.RuleWithCluster{{TargetCluster: spec.TargetCluster, Overriders: spec.Overriders}}
+			firstIndex = -1
+		}
+		for i, rule := range overrideRules {
+			if rule.TargetCluster != nil && !karmadautil.ClusterMatches(cluster, *rule.TargetCluster) {
+				continue
+			}
+			rules = append(rules, overrideRule{
+				policy:           ref,
+				ruleIndex:        firstIndex + i,
+				implicitPriority: m.priority,
+				overriders:       rule.Overriders,
+			})
+		}
+	}
+	return rules
+}
+
+// applyOverriders runs a single rule through Karmada's override manager, backed by a fake client that
+// only holds the target cluster and a ClusterOverridePolicy with that rule.
+func applyOverriders(obj *unstructured.Unstructured, cluster *clusterv1alpha1.Cluster, overriders policyv1alpha1.Overriders) error {
+	policy := &policyv1alpha1.ClusterOverridePolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "render"},
+		Spec: policyv1alpha1.OverrideSpec{
+			OverrideRules: []policyv1alpha1.RuleWithCluster{{Overriders: overriders}},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(karmadascheme.Scheme).WithObjects(cluster.DeepCopy(), policy).Build()
+	_, _, err := overridemanager.New(c, &record.FakeRecorder{}).ApplyOverridePolicies(obj, cluster.Name)
+	return err
+}
+
+func describePolicy(ref topology.OverridePolicyRef) string {
+	if ref.IsClusterScope {
+		return "ClusterOverridePolicy " + ref.Name
+	}
+	return "OverridePolicy " + ref.Namespace + "/" + ref.Name
+}
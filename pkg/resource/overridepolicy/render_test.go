@@ -0,0 +1,149 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package overridepolicy
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	karmadafake "github.com/karmada-io/karmada/pkg/generated/clientset/versioned/fake"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/karmada-io/dashboard/pkg/resource/topology"
+)
+
+func replicasRule(replicas string, targetCluster *policyv1alpha1.ClusterAffinity) policyv1alpha1.RuleWithCluster {
+	return policyv1alpha1.RuleWithCluster{
+		TargetCluster: targetCluster,
+		Overriders: policyv1alpha1.Overriders{
+			Plaintext: []policyv1alpha1.PlaintextOverrider{{
+				Path:     "/spec/replicas",
+				Operator: policyv1alpha1.OverriderOpReplace,
+				Value:    apiextensionsv1.JSON{Raw: []byte(replicas)},
+			}},
+		},
+	}
+}
+
+func TestRenderOverridePolicies(t *testing.T) {
+	template := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"name":      "nginx",
+			"namespace": "default",
+			"labels":    map[string]interface{}{"app": "nginx"},
+		},
+		"spec":   map[string]interface{}{"replicas": int64(1)},
+		"status": map[string]interface{}{"replicas": int64(1)},
+	}}
+	deploymentSelector := policyv1alpha1.ResourceSelector{APIVersion: "apps/v1", Kind: "Deployment"}
+	byLabel := deploymentSelector
+	byLabel.LabelSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"app": "nginx"}}
+	byName := deploymentSelector
+	byName.Name = "nginx"
+
+	karmadaClient := karmadafake.NewSimpleClientset(
+		&clusterv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "member1"}},
+		&policyv1alpha1.ClusterOverridePolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "global"},
+			Spec: policyv1alpha1.OverrideSpec{
+				OverrideRules: []policyv1alpha1.RuleWithCluster{{
+					Overriders: policyv1alpha1.Overriders{
+						LabelsOverrider: []policyv1alpha1.LabelAnnotationOverrider{{
+							Operator: policyv1alpha1.OverriderOpAdd,
+							Value:    map[string]string{"region": "east"},
+						}},
+					},
+				}},
+			},
+		},
+		// Sorted by name this one would come first, the name selector still applies it last.
+		&policyv1alpha1.OverridePolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "a-by-name", Namespace: "default"},
+			Spec: policyv1alpha1.OverrideSpec{
+				ResourceSelectors: []policyv1alpha1.ResourceSelector{byName},
+				OverrideRules: []policyv1alpha1.RuleWithCluster{
+					replicasRule("5", &policyv1alpha1.ClusterAffinity{ClusterNames: []string{"member2"}}),
+					replicasRule("3", &policyv1alpha1.ClusterAffinity{ClusterNames: []string{"member1"}}),
+				},
+			},
+		},
+		&policyv1alpha1.OverridePolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "b-by-label", Namespace: "default"},
+			Spec: policyv1alpha1.OverrideSpec{
+				ResourceSelectors: []policyv1alpha1.ResourceSelector{byLabel},
+				OverrideRules:     []policyv1alpha1.RuleWithCluster{replicasRule("2", nil)},
+			},
+		},
+		&policyv1alpha1.OverridePolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default"},
+			Spec: policyv1alpha1.OverrideSpec{
+				ResourceSelectors: []policyv1alpha1.ResourceSelector{{APIVersion: "v1", Kind: "Service"}},
+				OverrideRules:     []policyv1alpha1.RuleWithCluster{replicasRule("9", nil)},
+			},
+		},
+	)
+
+	rendering, err := RenderOverridePolicies(context.TODO(), karmadaClient, template, "member1")
+	if err != nil {
+		t.Fatalf("RenderOverridePolicies() error = %v", err)
+	}
+
+	var gotPolicies []topology.OverridePolicyRef
+	var gotRules []int
+	for _, o := range rendering.Overrides {
+		gotPolicies = append(gotPolicies, o.Policy)
+		gotRules = append(gotRules, o.RuleIndex)
+		if o.Diff == "" {
+			t.Errorf("override %s has an empty diff", o.Policy.Name)
+		}
+	}
+	wantPolicies := []topology.OverridePolicyRef{
+		{Name: "global", IsClusterScope: true},
+		{Name: "b-by-label", Namespace: "default"},
+		{Name: "a-by-name", Namespace: "default"},
+	}
+	if !reflect.DeepEqual(gotPolicies, wantPolicies) {
+		t.Errorf("applied policies = %v, want %v", gotPolicies, wantPolicies)
+	}
+	if want := []int{0, 0, 1}; !reflect.DeepEqual(gotRules, want) {
+		t.Errorf("applied rules = %v, want %v", gotRules, want)
+	}
+
+	replicas, _, _ := unstructured.NestedInt64(rendering.Manifest.Object, "spec", "replicas")
+	if replicas != 3 {
+		t.Errorf("rendered replicas = %d, want 3", replicas)
+	}
+	if region := rendering.Manifest.GetLabels()["region"]; region != "east" {
+		t.Errorf("rendered region label = %q, want east", region)
+	}
+	if _, found := rendering.Manifest.Object["status"]; found {
+		t.Errorf("rendered manifest still carries status")
+	}
+	if _, found := template.Object["status"]; !found {
+		t.Errorf("template was modified by rendering")
+	}
+	if len(rendering.AppliedOverrides) != 0 {
+		t.Errorf("appliedOverrides = %v, want none without a Work", rendering.AppliedOverrides)
+	}
+}
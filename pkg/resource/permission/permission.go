@@ -0,0 +1,196 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package permission
+
+import (
+	"context"
+	"sync"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+)
+
+// maxConcurrentReviews bounds the reviews in flight for a single matrix.
+const maxConcurrentReviews = 5
+
+// Resource is a resource whose permissions are reported.
+type Resource struct {
+	Group      string
+	Resource   string
+	Namespaced bool
+}
+
+// Key returns the resource.group name the resource is reported under, e.g. deployments.apps.
+func (r Resource) Key() string {
+	if r.Group == "" {
+		return r.Resource
+	}
+	return r.Resource + "." + r.Group
+}
+
+// KeyResources are the resources the dashboard offers actions on: clusters, policies, workloads and secrets.
+var KeyResources = []Resource{
+	{Group: "cluster.karmada.io", Resource: "clusters"},
+	{Group: "policy.karmada.io", Resource: "clusterpropagationpolicies"},
+	{Group: "policy.karmada.io", Resource: "clusteroverridepolicies"},
+	{Group: "policy.karmada.io", Resource: "propagationpolicies", Namespaced: true},
+	{Group: "policy.karmada.io", Resource: "overridepolicies", Namespaced: true},
+	{Group: "apps", Resource: "deployments", Namespaced: true},
+	{Group: "apps", Resource: "statefulsets", Namespaced: true},
+	{Group: "apps", Resource: "daemonsets", Namespaced: true},
+	{Group: "batch", Resource: "jobs", Namespaced: true},
+	{Group: "batch", Resource: "cronjobs", Namespaced: true},
+	{Resource: "secrets", Namespaced: true},
+}
+
+// Verbs are the verbs reported for every resource.
+var Verbs = []string{"get", "list", "watch", "create", "update", "patch", "delete"}
+
+// Permissions maps a resource key to the verbs allowed on it, resources without any allowed verb are left out.
+type Permissions map[string][]string
+
+// Matrix is what the caller may do with the key resources.
+type Matrix struct {
+	// Cluster holds the permissions on cluster-scoped resources.
+	Cluster Permissions `json:"cluster"`
+	// Namespaces holds the permissions on namespaced resources, per namespace.
+	Namespaces map[string]Permissions `json:"namespaces"`
+	// Incomplete is set when the apiserver could not list every rule of a namespace, e.g. because a webhook
+	// authorizer is in use. Namespaced verbs missing from the matrix may then still be allowed.
+	Incomplete bool `json:"incomplete"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// GetMatrix reviews the caller's rules in each namespace with SelfSubjectRulesReview, and asks for each verb on
+// each cluster-scoped resource with a SelfSubjectAccessReview without a namespace, as rules reviews only cover
+// namespaces.
+func GetMatrix(ctx context.Context, client kubernetes.Interface, namespaces []string) *Matrix {
+	if len(namespaces) == 0 {
+		namespaces = []string{metav1.NamespaceDefault}
+	}
+	var clusterAttributes []authorizationv1.ResourceAttributes
+	for _, resource := range KeyResources {
+		if resource.Namespaced {
+			continue
+		}
+		for _, verb := range Verbs {
+			clusterAttributes = append(clusterAttributes, authorizationv1.ResourceAttributes{
+				Group:    resource.Group,
+				Resource: resource.Resource,
+				Verb:     verb,
+			})
+		}
+	}
+
+	rulesReviews := make([]*authorizationv1.SelfSubjectRulesReview, len(namespaces))
+	rulesErrs := make([]error, len(namespaces))
+	accessReviews := make([]*authorizationv1.SelfSubjectAccessReview, len(clusterAttributes))
+	accessErrs := make([]error, len(clusterAttributes))
+	sem := make(chan struct{}, maxConcurrentReviews)
+	var wg sync.WaitGroup
+	review := func(create func()) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			create()
+		}()
+	}
+	for i, namespace := range namespaces {
+		review(func() {
+			rulesReviews[i], rulesErrs[i] = client.AuthorizationV1().SelfSubjectRulesReviews().Create(ctx,
+				&authorizationv1.SelfSubjectRulesReview{
+					Spec: authorizationv1.SelfSubjectRulesReviewSpec{Namespace: namespace},
+				}, metav1.CreateOptions{})
+		})
+	}
+	for i := range clusterAttributes {
+		review(func() {
+			accessReviews[i], accessErrs[i] = client.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx,
+				&authorizationv1.SelfSubjectAccessReview{
+					Spec: authorizationv1.SelfSubjectAccessReviewSpec{ResourceAttributes: &clusterAttributes[i]},
+				}, metav1.CreateOptions{})
+		})
+	}
+	wg.Wait()
+
+	matrix := &Matrix{
+		Cluster:    Permissions{},
+		Namespaces: map[string]Permissions{},
+		Errors:     []error{},
+	}
+	for i, attributes := range clusterAttributes {
+		if accessErrs[i] != nil {
+			matrix.Errors = append(matrix.Errors, accessErrs[i])
+			continue
+		}
+		if accessReviews[i].Status.Allowed {
+			key := Resource{Group: attributes.Group, Resource: attributes.Resource}.Key()
+			matrix.Cluster[key] = append(matrix.Cluster[key], attributes.Verb)
+		}
+	}
+	for i, namespace := range namespaces {
+		if rulesErrs[i] != nil {
+			matrix.Errors = append(matrix.Errors, rulesErrs[i])
+			continue
+		}
+		status := rulesReviews[i].Status
+		matrix.Incomplete = matrix.Incomplete || status.Incomplete
+		namespaced := Permissions{}
+		for _, resource := range KeyResources {
+			if !resource.Namespaced {
+				continue
+			}
+			if verbs := allowedVerbs(status.ResourceRules, resource); len(verbs) > 0 {
+				namespaced[resource.Key()] = sets.List(verbs)
+			}
+		}
+		matrix.Namespaces[namespace] = namespaced
+	}
+	return matrix
+}
+
+// allowedVerbs returns the verbs rules allow on every object of resource. Rules limited to resource names
+// do not count, they do not allow listing or creating.
+func allowedVerbs(rules []authorizationv1.ResourceRule, resource Resource) sets.Set[string] {
+	verbs := sets.New[string]()
+	for _, rule := range rules {
+		if len(rule.ResourceNames) > 0 || !matches(rule.APIGroups, resource.Group) || !matches(rule.Resources, resource.Resource) {
+			continue
+		}
+		for _, verb := range Verbs {
+			if matches(rule.Verbs, verb) {
+				verbs.Insert(verb)
+			}
+		}
+	}
+	return verbs
+}
+
+func matches(values []string, value string) bool {
+	for _, v := range values {
+		if v == "*" || v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package permission
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestGetMatrix(t *testing.T) {
+	clusterAllowed := map[string]bool{
+		"clusters/get": true, "clusters/list": true, "clusters/watch": true,
+	}
+	for _, verb := range Verbs {
+		clusterAllowed["clusterpropagationpolicies/"+verb] = true
+	}
+	rules := map[string][]authorizationv1.ResourceRule{
+		"default": {
+			{Verbs: []string{"*"}, APIGroups: []string{"apps"}, Resources: []string{"*"}},
+			{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"secrets"}, ResourceNames: []string{"token"}},
+			// Rules reviews do not decide cluster-scoped permissions, access reviews do.
+			{Verbs: []string{"delete"}, APIGroups: []string{"cluster.karmada.io"}, Resources: []string{"clusters"}},
+		},
+		"dev": {
+			{Verbs: []string{"get", "list"}, APIGroups: []string{""}, Resources: []string{"secrets"}},
+		},
+	}
+
+	client := fake.NewSimpleClientset()
+	client.PrependReactor("create", "selfsubjectrulesreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectRulesReview)
+		namespaceRules, ok := rules[review.Spec.Namespace]
+		if !ok {
+			return true, nil, errors.New("review failed")
+		}
+		review.Status.ResourceRules = namespaceRules
+		review.Status.Incomplete = review.Spec.Namespace == "dev"
+		return true, review, nil
+	})
+	client.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+		attributes := review.Spec.ResourceAttributes
+		if attributes.Namespace != "" {
+			return true, nil, errors.New("cluster-scoped resource reviewed in a namespace")
+		}
+		if attributes.Resource == "clusteroverridepolicies" && attributes.Verb == "delete" {
+			return true, nil, errors.New("review failed")
+		}
+		review.Status.Allowed = clusterAllowed[attributes.Resource+"/"+attributes.Verb]
+		return true, review, nil
+	})
+
+	matrix := GetMatrix(context.TODO(), client, []string{"default", "dev", "broken"})
+
+	wantCluster := Permissions{
+		"clusters.cluster.karmada.io":                  {"get", "list", "watch"},
+		"clusterpropagationpolicies.policy.karmada.io": Verbs,
+	}
+	if !sameVerbs(matrix.Cluster, wantCluster) {
+		t.Errorf("Cluster = %v, want %v", matrix.Cluster, wantCluster)
+	}
+	wantNamespaces := map[string]Permissions{
+		"default": {
+			"deployments.apps":  Verbs,
+			"statefulsets.apps": Verbs,
+			"daemonsets.apps":   Verbs,
+		},
+		"dev": {"secrets": {"get", "list"}},
+	}
+	for namespace, want := range wantNamespaces {
+		if got := matrix.Namespaces[namespace]; !sameVerbs(got, want) {
+			t.Errorf("Namespaces[%s] = %v, want %v", namespace, got, want)
+		}
+	}
+	if _, found := matrix.Namespaces["broken"]; found {
+		t.Errorf("failed review is reported in Namespaces")
+	}
+	if len(matrix.Errors) != 2 {
+		t.Errorf("Errors = %v, want the failed rules and access reviews", matrix.Errors)
+	}
+	if !matrix.Incomplete {
+		t.Errorf("Incomplete = false, want true")
+	}
+}
+
+// sameVerbs compares permissions regardless of the order of the verbs.
+func sameVerbs(got, want Permissions) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	for key, verbs := range want {
+		if len(got[key]) != len(verbs) {
+			return false
+		}
+		allowed := map[string]bool{}
+		for _, verb := range got[key] {
+			allowed[verb] = true
+		}
+		for _, verb := range verbs {
+			if !allowed[verb] {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,371 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package propagationpolicy
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	karmadautil "github.com/karmada-io/karmada/pkg/util"
+	karmadanames "github.com/karmada-io/karmada/pkg/util/names"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/restmapper"
+	"k8s.io/klog/v2"
+)
+
+// PolicyRef is a reference to a PropagationPolicy or ClusterPropagationPolicy.
+type PolicyRef struct {
+	Name           string `json:"name"`
+	Namespace      string `json:"namespace,omitempty"`
+	IsClusterScope bool   `json:"isClusterScope"`
+}
+
+// MatchedResource is a resource template selected by the previewed policy.
+type MatchedResource struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+	// CurrentPolicy is the policy that claims the resource today, nil if it is not propagated.
+	CurrentPolicy *PolicyRef `json:"currentPolicy,omitempty"`
+	// WillClaim tells whether the previewed policy would take over the resource once applied.
+	WillClaim bool `json:"willClaim"`
+}
+
+// CandidateCluster is the placement verdict of the previewed policy for a single cluster.
+type CandidateCluster struct {
+	Name string `json:"name"`
+	// AffinityName is the name of the ClusterAffinities term the cluster was evaluated against.
+	AffinityName    string `json:"affinityName,omitempty"`
+	AffinityMatched bool   `json:"affinityMatched"`
+	// UntoleratedTaints are the NoSchedule and NoExecute taints not tolerated by the policy.
+	UntoleratedTaints []corev1.Taint `json:"untoleratedTaints,omitempty"`
+	// Feasible tells whether the cluster passes both affinity and taint checks.
+	Feasible bool `json:"feasible"`
+}
+
+// SpreadConstraintResult is the evaluation of a spread constraint against the feasible clusters.
+type SpreadConstraintResult struct {
+	policyv1alpha1.SpreadConstraint `json:",inline"`
+	// Groups are the distinct values of the spread field or label among feasible clusters.
+	Groups    []string `json:"groups"`
+	Satisfied bool     `json:"satisfied"`
+}
+
+// PolicyPreview is the impact of applying a PropagationPolicy or ClusterPropagationPolicy, computed without applying it.
+type PolicyPreview struct {
+	MatchedResources  []MatchedResource        `json:"matchedResources"`
+	Clusters          []CandidateCluster       `json:"clusters"`
+	SpreadConstraints []SpreadConstraintResult `json:"spreadConstraints"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// PreviewPropagationPolicy evaluates the resource selectors and placement of a policy against the
+// current resource templates and Cluster objects. policy.Namespace is empty for a ClusterPropagationPolicy.
+func PreviewPropagationPolicy(
+	ctx context.Context,
+	karmadaClient karmadaclientset.Interface,
+	discoveryClient discovery.DiscoveryInterface,
+	dynamicClient dynamic.Interface,
+	policy PolicyRef,
+	spec policyv1alpha1.PropagationSpec) (*PolicyPreview, error) {
+	preview := &PolicyPreview{
+		MatchedResources:  []MatchedResource{},
+		Clusters:          []CandidateCluster{},
+		SpreadConstraints: []SpreadConstraintResult{},
+		Errors:            []error{},
+	}
+
+	mapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(discoveryClient))
+	seen := map[types.UID]bool{}
+	priorities := map[PolicyRef]int32{}
+	for _, rs := range spec.ResourceSelectors {
+		resources, err := listSelectedResources(ctx, dynamicClient, mapper, policy, rs)
+		if err != nil {
+			klog.V(4).InfoS("Failed to list selected resources", "apiVersion", rs.APIVersion, "kind", rs.Kind, "err", err)
+			preview.Errors = append(preview.Errors, err)
+			continue
+		}
+		for i := range resources {
+			resource := &resources[i]
+			if seen[resource.GetUID()] {
+				continue
+			}
+			seen[resource.GetUID()] = true
+			current := claimingPolicy(resource.GetAnnotations())
+			willClaim, err := willClaim(ctx, karmadaClient, priorities, policy, spec, current)
+			if err != nil {
+				preview.Errors = append(preview.Errors, err)
+			}
+			preview.MatchedResources = append(preview.MatchedResources, MatchedResource{
+				APIVersion:    resource.GetAPIVersion(),
+				Kind:          resource.GetKind(),
+				Namespace:     resource.GetNamespace(),
+				Name:          resource.GetName(),
+				CurrentPolicy: current,
+				WillClaim:     willClaim,
+			})
+		}
+	}
+
+	clusters, err := karmadaClient.ClusterV1alpha1().Clusters().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	var feasible []*clusterv1alpha1.Cluster
+	preview.Clusters, feasible = evaluateClusters(clusters.Items, spec.Placement)
+	preview.SpreadConstraints = EvaluateSpreadConstraints(feasible, spec.Placement.SpreadConstraints)
+	return preview, nil
+}
+
+// listSelectedResources returns the resource templates matching a resource selector.
+// A PropagationPolicy only selects resources in its own namespace.
+func listSelectedResources(ctx context.Context, dynamicClient dynamic.Interface, mapper meta.RESTMapper,
+	policy PolicyRef, rs policyv1alpha1.ResourceSelector) ([]unstructured.Unstructured, error) {
+	gv, err := schema.ParseGroupVersion(rs.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+	mapping, err := mapper.RESTMapping(schema.GroupKind{Group: gv.Group, Kind: rs.Kind}, gv.Version)
+	if err != nil {
+		return nil, fmt.Errorf("resolve %s %s: %w", rs.APIVersion, rs.Kind, err)
+	}
+	if !policy.IsClusterScope {
+		rs.Namespace = policy.Namespace
+	}
+	namespace := rs.Namespace
+	if mapping.Scope.Name() == meta.RESTScopeNameRoot {
+		namespace = ""
+	}
+
+	var candidates []unstructured.Unstructured
+	if rs.Name != "" && (namespace != "" || mapping.Scope.Name() == meta.RESTScopeNameRoot) {
+		obj, err := dynamicClient.Resource(mapping.Resource).Namespace(namespace).Get(ctx, rs.Name, metav1.GetOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		candidates = append(candidates, *obj)
+	} else {
+		listOptions := metav1.ListOptions{}
+		if rs.Name == "" && rs.LabelSelector != nil {
+			listOptions.LabelSelector = metav1.FormatLabelSelector(rs.LabelSelector)
+		}
+		list, err := dynamicClient.Resource(mapping.Resource).Namespace(namespace).List(ctx, listOptions)
+		if err != nil {
+			return nil, err
+		}
+		candidates = list.Items
+	}
+
+	var matched []unstructured.Unstructured
+	for i := range candidates {
+		if isSkippedNamespace(candidates[i].GetNamespace()) {
+			continue
+		}
+		if karmadautil.ResourceMatches(&candidates[i], rs) {
+			matched = append(matched, candidates[i])
+		}
+	}
+	return matched, nil
+}
+
+// isSkippedNamespace mirrors the namespaces Karmada never propagates from: its reserved namespaces
+// and, by default of --skipped-propagating-namespaces, the kube-* namespaces.
+func isSkippedNamespace(namespace string) bool {
+	return karmadanames.IsReservedNamespace(namespace) || strings.HasPrefix(namespace, "kube-")
+}
+
+// claimingPolicy reads the policy that currently claims a resource template from its annotations.
+func claimingPolicy(annotations map[string]string) *PolicyRef {
+	if name := annotations[policyv1alpha1.PropagationPolicyNameAnnotation]; name != "" {
+		return &PolicyRef{Name: name, Namespace: annotations[policyv1alpha1.PropagationPolicyNamespaceAnnotation]}
+	}
+	if name := annotations[policyv1alpha1.ClusterPropagationPolicyAnnotation]; name != "" {
+		return &PolicyRef{Name: name, IsClusterScope: true}
+	}
+	return nil
+}
+
+// willClaim tells whether the previewed policy would claim a resource currently claimed by current.
+// A claimed resource only moves with preemption enabled: a PropagationPolicy preempts any
+// ClusterPropagationPolicy, otherwise only a policy of the same scope with a higher priority preempts.
+func willClaim(ctx context.Context, karmadaClient karmadaclientset.Interface, priorities map[PolicyRef]int32,
+	policy PolicyRef, spec policyv1alpha1.PropagationSpec, current *PolicyRef) (bool, error) {
+	if current == nil || *current == policy {
+		return true, nil
+	}
+	if spec.Preemption != policyv1alpha1.PreemptAlways {
+		return false, nil
+	}
+	if current.IsClusterScope != policy.IsClusterScope {
+		return !policy.IsClusterScope, nil
+	}
+	currentPriority, ok := priorities[*current]
+	if !ok {
+		var err error
+		if current.IsClusterScope {
+			var cpp *policyv1alpha1.ClusterPropagationPolicy
+			cpp, err = karmadaClient.PolicyV1alpha1().ClusterPropagationPolicies().Get(ctx, current.Name, metav1.GetOptions{})
+			if err == nil {
+				currentPriority = cpp.ExplicitPriority()
+			}
+		} else {
+			var pp *policyv1alpha1.PropagationPolicy
+			pp, err = karmadaClient.PolicyV1alpha1().PropagationPolicies(current.Namespace).Get(ctx, current.Name, metav1.GetOptions{})
+			if err == nil {
+				currentPriority = pp.ExplicitPriority()
+			}
+		}
+		if err != nil {
+			return false, err
+		}
+		priorities[*current] = currentPriority
+	}
+	priority := int32(0)
+	if spec.Priority != nil {
+		priority = *spec.Priority
+	}
+	return priority > currentPriority, nil
+}
+
+// evaluateClusters checks every cluster against the placement's cluster affinity and tolerations.
+// With ClusterAffinities the terms are tried in order and the first term with a feasible cluster wins,
+// the same way the scheduler falls back between affinity groups.
+func evaluateClusters(clusters []clusterv1alpha1.Cluster, placement policyv1alpha1.Placement) ([]CandidateCluster, []*clusterv1alpha1.Cluster) {
+	if len(placement.ClusterAffinities) == 0 {
+		affinity := policyv1alpha1.ClusterAffinity{}
+		if placement.ClusterAffinity != nil {
+			affinity = *placement.ClusterAffinity
+		}
+		return evaluateClustersForAffinity(clusters, "", affinity, placement.ClusterTolerations)
+	}
+	var candidates []CandidateCluster
+	var feasible []*clusterv1alpha1.Cluster
+	for _, term := range placement.ClusterAffinities {
+		candidates, feasible = evaluateClustersForAffinity(clusters, term.AffinityName, term.ClusterAffinity, placement.ClusterTolerations)
+		if len(feasible) > 0 {
+			break
+		}
+	}
+	return candidates, feasible
+}
+
+func evaluateClustersForAffinity(clusters []clusterv1alpha1.Cluster, affinityName string, affinity policyv1alpha1.ClusterAffinity,
+	tolerations []corev1.Toleration) ([]CandidateCluster, []*clusterv1alpha1.Cluster) {
+	candidates := make([]CandidateCluster, 0, len(clusters))
+	var feasible []*clusterv1alpha1.Cluster
+	for i := range clusters {
+		cluster := &clusters[i]
+		candidate := CandidateCluster{
+			Name:              cluster.Name,
+			AffinityName:      affinityName,
+			AffinityMatched:   karmadautil.ClusterMatches(cluster, affinity),
+			UntoleratedTaints: UntoleratedTaints(cluster.Spec.Taints, tolerations),
+		}
+		candidate.Feasible = candidate.AffinityMatched && len(candidate.UntoleratedTaints) == 0
+		if candidate.Feasible {
+			feasible = append(feasible, cluster)
+		}
+		candidates = append(candidates, candidate)
+	}
+	return candidates, feasible
+}
+
+// UntoleratedTaints returns the taints that keep the scheduler away from a cluster, i.e. NoSchedule
+// and NoExecute taints without a matching toleration.
+func UntoleratedTaints(taints []corev1.Taint, tolerations []corev1.Toleration) []corev1.Taint {
+	var result []corev1.Taint
+	for i := range taints {
+		taint := &taints[i]
+		if taint.Effect != corev1.TaintEffectNoSchedule && taint.Effect != corev1.TaintEffectNoExecute {
+			continue
+		}
+		tolerated := false
+		for j := range tolerations {
+			if tolerations[j].ToleratesTaint(klog.Background(), taint, false) {
+				tolerated = true
+				break
+			}
+		}
+		if !tolerated {
+			result = append(result, *taint)
+		}
+	}
+	return result
+}
+
+// EvaluateSpreadConstraints groups the feasible clusters by each constraint's field or label and
+// checks that there are at least MinGroups groups.
+func EvaluateSpreadConstraints(feasible []*clusterv1alpha1.Cluster, constraints []policyv1alpha1.SpreadConstraint) []SpreadConstraintResult {
+	results := make([]SpreadConstraintResult, 0, len(constraints))
+	for _, constraint := range constraints {
+		groupSet := map[string]bool{}
+		for _, cluster := range feasible {
+			for _, group := range spreadGroups(cluster, constraint) {
+				if group != "" {
+					groupSet[group] = true
+				}
+			}
+		}
+		groups := make([]string, 0, len(groupSet))
+		for group := range groupSet {
+			groups = append(groups, group)
+		}
+		sort.Strings(groups)
+		results = append(results, SpreadConstraintResult{
+			SpreadConstraint: constraint,
+			Groups:           groups,
+			Satisfied:        len(groups) >= constraint.MinGroups,
+		})
+	}
+	return results
+}
+
+func spreadGroups(cluster *clusterv1alpha1.Cluster, constraint policyv1alpha1.SpreadConstraint) []string {
+	if constraint.SpreadByLabel != "" {
+		return []string{cluster.Labels[constraint.SpreadByLabel]}
+	}
+	switch constraint.SpreadByField {
+	case policyv1alpha1.SpreadByFieldRegion:
+		return []string{cluster.Spec.Region}
+	case policyv1alpha1.SpreadByFieldZone:
+		return cluster.Spec.Zones
+	case policyv1alpha1.SpreadByFieldProvider:
+		return []string{cluster.Spec.Provider}
+	default:
+		return []string{cluster.Name}
+	}
+}
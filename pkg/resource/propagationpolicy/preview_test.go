@@ -0,0 +1,151 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package propagationpolicy
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newCluster(name, region string, labels map[string]string, taints ...corev1.Taint) clusterv1alpha1.Cluster {
+	return clusterv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+		Spec:       clusterv1alpha1.ClusterSpec{Region: region, Taints: taints},
+	}
+}
+
+func TestEvaluateClusters(t *testing.T) {
+	clusters := []clusterv1alpha1.Cluster{
+		newCluster("member1", "east", map[string]string{"env": "prod"}),
+		newCluster("member2", "west", map[string]string{"env": "prod"},
+			corev1.Taint{Key: "maintenance", Effect: corev1.TaintEffectNoSchedule}),
+		newCluster("member3", "west", map[string]string{"env": "dev"}),
+	}
+
+	testCases := []struct {
+		name         string
+		placement    policyv1alpha1.Placement
+		wantFeasible []string
+	}{
+		{
+			name:         "no affinity selects every untainted cluster",
+			placement:    policyv1alpha1.Placement{},
+			wantFeasible: []string{"member1", "member3"},
+		},
+		{
+			name: "label affinity",
+			placement: policyv1alpha1.Placement{
+				ClusterAffinity: &policyv1alpha1.ClusterAffinity{
+					LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+				},
+			},
+			wantFeasible: []string{"member1"},
+		},
+		{
+			name: "toleration admits tainted cluster",
+			placement: policyv1alpha1.Placement{
+				ClusterAffinity: &policyv1alpha1.ClusterAffinity{ClusterNames: []string{"member2"}},
+				ClusterTolerations: []corev1.Toleration{
+					{Key: "maintenance", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+				},
+			},
+			wantFeasible: []string{"member2"},
+		},
+		{
+			name: "affinities fall back to the next term",
+			placement: policyv1alpha1.Placement{
+				ClusterAffinities: []policyv1alpha1.ClusterAffinityTerm{
+					{AffinityName: "primary", ClusterAffinity: policyv1alpha1.ClusterAffinity{ClusterNames: []string{"member2"}}},
+					{AffinityName: "backup", ClusterAffinity: policyv1alpha1.ClusterAffinity{ClusterNames: []string{"member3"}}},
+				},
+			},
+			wantFeasible: []string{"member3"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, feasible := evaluateClusters(clusters, tc.placement)
+			var got []string
+			for _, cluster := range feasible {
+				got = append(got, cluster.Name)
+			}
+			if !reflect.DeepEqual(got, tc.wantFeasible) {
+				t.Errorf("evaluateClusters() feasible = %v, want %v", got, tc.wantFeasible)
+			}
+		})
+	}
+}
+
+func TestEvaluateSpreadConstraints(t *testing.T) {
+	member1 := newCluster("member1", "east", nil)
+	member2 := newCluster("member2", "west", nil)
+	member3 := newCluster("member3", "west", nil)
+	feasible := []*clusterv1alpha1.Cluster{&member1, &member2, &member3}
+
+	results := EvaluateSpreadConstraints(feasible, []policyv1alpha1.SpreadConstraint{
+		{SpreadByField: policyv1alpha1.SpreadByFieldRegion, MinGroups: 3, MaxGroups: 3},
+		{SpreadByField: policyv1alpha1.SpreadByFieldCluster, MinGroups: 2, MaxGroups: 3},
+	})
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(results))
+	}
+	if !reflect.DeepEqual(results[0].Groups, []string{"east", "west"}) || results[0].Satisfied {
+		t.Errorf("region constraint = %v satisfied=%v, want [east west] unsatisfied", results[0].Groups, results[0].Satisfied)
+	}
+	if len(results[1].Groups) != 3 || !results[1].Satisfied {
+		t.Errorf("cluster constraint = %v satisfied=%v, want 3 groups satisfied", results[1].Groups, results[1].Satisfied)
+	}
+}
+
+func TestWillClaim(t *testing.T) {
+	pp := PolicyRef{Name: "pp", Namespace: "default"}
+	cpp := PolicyRef{Name: "cpp", IsClusterScope: true}
+	always := policyv1alpha1.PropagationSpec{Preemption: policyv1alpha1.PreemptAlways}
+
+	testCases := []struct {
+		name    string
+		policy  PolicyRef
+		spec    policyv1alpha1.PropagationSpec
+		current *PolicyRef
+		want    bool
+	}{
+		{name: "unclaimed resource", policy: pp, current: nil, want: true},
+		{name: "claimed by the same policy", policy: pp, current: &PolicyRef{Name: "pp", Namespace: "default"}, want: true},
+		{name: "claimed by another policy without preemption", policy: pp, current: &cpp, want: false},
+		{name: "propagation policy preempts cluster policy", policy: pp, spec: always, current: &cpp, want: true},
+		{name: "cluster policy never preempts propagation policy", policy: cpp, spec: always, current: &pp, want: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := willClaim(context.TODO(), nil, map[PolicyRef]int32{}, tc.policy, tc.spec, tc.current)
+			if err != nil {
+				t.Fatalf("willClaim() unexpected error: %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("willClaim() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcebinding
+
+import (
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+)
+
+// ResourceBindingCell is a wrapper around ResourceBinding type
+type ResourceBindingCell workv1alpha2.ResourceBinding
+
+// GetProperty returns the given property of the ResourceBinding.
+func (c ResourceBindingCell) GetProperty(name dataselect.PropertyName) dataselect.ComparableValue {
+	switch name {
+	case dataselect.NameProperty:
+		return dataselect.StdComparableString(c.ObjectMeta.Name)
+	case dataselect.CreationTimestampProperty:
+		return dataselect.StdComparableTime(c.ObjectMeta.CreationTimestamp.Time)
+	case dataselect.NamespaceProperty:
+		return dataselect.StdComparableString(c.ObjectMeta.Namespace)
+	case dataselect.ClusterProperty:
+		return dataselect.StdComparableStringSlice(TargetClusterNames(c.Spec.Clusters))
+	case dataselect.ScheduledProperty:
+		return dataselect.StdComparableString(ConditionStatus(c.Status.Conditions, workv1alpha2.Scheduled))
+	case dataselect.FullyAppliedProperty:
+		return dataselect.StdComparableString(ConditionStatus(c.Status.Conditions, workv1alpha2.FullyApplied))
+	case dataselect.ResourceKindProperty:
+		return dataselect.StdComparableString(c.Spec.Resource.Kind)
+	case dataselect.ResourceNameProperty:
+		return dataselect.StdComparableString(c.Spec.Resource.Name)
+	default:
+		// if name is not supported then just return a constant dummy value, sort will have no effect.
+		return nil
+	}
+}
+
+// TargetClusterNames returns the names of the clusters a binding is scheduled to.
+func TargetClusterNames(clusters []workv1alpha2.TargetCluster) []string {
+	names := make([]string, 0, len(clusters))
+	for _, cluster := range clusters {
+		names = append(names, cluster.Name)
+	}
+	return names
+}
+
+// ConditionStatus returns the status of the condition with the given type,
+// metav1.ConditionUnknown if the condition is not reported yet.
+func ConditionStatus(conditions []metav1.Condition, conditionType string) metav1.ConditionStatus {
+	condition := meta.FindStatusCondition(conditions, conditionType)
+	if condition == nil {
+		return metav1.ConditionUnknown
+	}
+	return condition.Status
+}
+
+func toCells(std []workv1alpha2.ResourceBinding) []dataselect.DataCell {
+	cells := make([]dataselect.DataCell, len(std))
+	for i := range std {
+		cells[i] = ResourceBindingCell(std[i])
+	}
+	return cells
+}
+
+func fromCells(cells []dataselect.DataCell) []workv1alpha2.ResourceBinding {
+	std := make([]workv1alpha2.ResourceBinding, len(cells))
+	for i := range std {
+		std[i] = workv1alpha2.ResourceBinding(cells[i].(ResourceBindingCell))
+	}
+	return std
+}
@@ -0,0 +1,243 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcebinding
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"github.com/karmada-io/karmada/pkg/util/names"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/karmada-io/dashboard/pkg/resource/event"
+)
+
+// Types of failover timeline entries.
+const (
+	// TimelineEntryEviction is a graceful eviction task recorded on the binding.
+	TimelineEntryEviction = "Eviction"
+	// TimelineEntryClusterCondition is the last transition of a condition of an involved cluster.
+	TimelineEntryClusterCondition = "ClusterCondition"
+	// TimelineEntryEvent is an event about the resource template, its binding or an involved cluster.
+	TimelineEntryEvent = "Event"
+)
+
+// TimelineEntry is a single point in the failover history of a resource template.
+type TimelineEntry struct {
+	Time    metav1.Time `json:"time"`
+	Type    string      `json:"type"`
+	Cluster string      `json:"cluster,omitempty"`
+	Reason  string      `json:"reason"`
+	Message string      `json:"message,omitempty"`
+
+	// Eviction is set for Eviction entries.
+	Eviction *workv1alpha2.GracefulEvictionTask `json:"eviction,omitempty"`
+	// MovedTo are the clusters the binding is scheduled to now that it was not before the eviction.
+	MovedTo []string `json:"movedTo,omitempty"`
+	// ConditionStatus is set for ClusterCondition entries.
+	ConditionStatus metav1.ConditionStatus `json:"conditionStatus,omitempty"`
+	// EventType is Normal or Warning for Event entries.
+	EventType string `json:"eventType,omitempty"`
+	// InvolvedObject is "Kind/name" of the object an Event entry is about.
+	InvolvedObject string `json:"involvedObject,omitempty"`
+}
+
+// FailoverHistory is the timeline of evictions, cluster condition transitions and events of a resource template.
+type FailoverHistory struct {
+	Resource         workv1alpha2.ObjectReference `json:"resource"`
+	BindingName      string                       `json:"bindingName"`
+	BindingNamespace string                       `json:"bindingNamespace,omitempty"`
+	// CurrentClusters is the current scheduling result, i.e. where evicted replicas went.
+	CurrentClusters []workv1alpha2.TargetCluster `json:"currentClusters"`
+	// Timeline is sorted from the oldest to the newest entry.
+	Timeline []TimelineEntry `json:"timeline"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// GetFailoverHistory assembles the failover timeline of the resource template kind/name. An empty namespace
+// stands for a cluster-scoped template, traced through its ClusterResourceBinding.
+func GetFailoverHistory(ctx context.Context, karmadaClient karmadaclientset.Interface, k8sClient kubernetes.Interface,
+	namespace, kind, name string) (*FailoverHistory, error) {
+	bindingName := names.GenerateBindingName(kind, name)
+	var spec workv1alpha2.ResourceBindingSpec
+	if namespace == "" {
+		crb, err := karmadaClient.WorkV1alpha2().ClusterResourceBindings().Get(ctx, bindingName, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		spec = crb.Spec
+	} else {
+		rb, err := karmadaClient.WorkV1alpha2().ResourceBindings(namespace).Get(ctx, bindingName, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		spec = rb.Spec
+	}
+
+	history := &FailoverHistory{
+		Resource:         spec.Resource,
+		BindingName:      bindingName,
+		BindingNamespace: namespace,
+		CurrentClusters:  spec.Clusters,
+		Timeline:         []TimelineEntry{},
+		Errors:           []error{},
+	}
+	history.Timeline = append(history.Timeline, evictionEntries(spec.GracefulEvictionTasks, spec.Clusters)...)
+
+	for _, clusterName := range involvedClusters(&spec) {
+		cluster, err := karmadaClient.ClusterV1alpha1().Clusters().Get(ctx, clusterName, metav1.GetOptions{})
+		if err != nil {
+			// The cluster may have been unjoined since, its events still tell the story.
+			history.Errors = append(history.Errors, err)
+		} else {
+			for _, condition := range cluster.Status.Conditions {
+				history.Timeline = append(history.Timeline, TimelineEntry{
+					Time:            condition.LastTransitionTime,
+					Type:            TimelineEntryClusterCondition,
+					Cluster:         clusterName,
+					Reason:          condition.Reason,
+					Message:         condition.Message,
+					ConditionStatus: condition.Status,
+					InvolvedObject:  condition.Type,
+				})
+			}
+		}
+		entries, err := eventEntries(k8sClient, "", clusterName, "Cluster")
+		if err != nil {
+			history.Errors = append(history.Errors, err)
+		}
+		history.Timeline = append(history.Timeline, entries...)
+	}
+
+	// Events of cluster-scoped objects are recorded in the default namespace.
+	eventNamespace, bindingKind := namespace, "ResourceBinding"
+	if namespace == "" {
+		eventNamespace, bindingKind = metav1.NamespaceDefault, "ClusterResourceBinding"
+	}
+	for _, object := range []struct{ name, kind string }{{spec.Resource.Name, spec.Resource.Kind}, {bindingName, bindingKind}} {
+		entries, err := eventEntries(k8sClient, eventNamespace, object.name, object.kind)
+		if err != nil {
+			history.Errors = append(history.Errors, err)
+		}
+		history.Timeline = append(history.Timeline, entries...)
+	}
+
+	sort.SliceStable(history.Timeline, func(i, j int) bool {
+		return history.Timeline[i].Time.Before(&history.Timeline[j].Time)
+	})
+	return history, nil
+}
+
+// evictionEntries turns graceful eviction tasks into timeline entries. Replicas moved to the clusters that
+// are scheduled now but were not before the failover.
+func evictionEntries(tasks []workv1alpha2.GracefulEvictionTask, current []workv1alpha2.TargetCluster) []TimelineEntry {
+	entries := make([]TimelineEntry, 0, len(tasks))
+	for i := range tasks {
+		task := &tasks[i]
+		before := map[string]bool{task.FromCluster: true}
+		for _, c := range task.ClustersBeforeFailover {
+			before[c] = true
+		}
+		var movedTo []string
+		for _, c := range current {
+			if !before[c.Name] {
+				movedTo = append(movedTo, c.Name)
+			}
+		}
+		entry := TimelineEntry{
+			Type:     TimelineEntryEviction,
+			Cluster:  task.FromCluster,
+			Reason:   task.Reason,
+			Message:  task.Message,
+			Eviction: task,
+			MovedTo:  movedTo,
+		}
+		if task.CreationTimestamp != nil {
+			entry.Time = *task.CreationTimestamp
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// involvedClusters returns the clusters the binding is scheduled to or is being evicted from.
+func involvedClusters(spec *workv1alpha2.ResourceBindingSpec) []string {
+	seen := map[string]bool{}
+	var clusters []string
+	add := func(name string) {
+		if name != "" && !seen[name] {
+			seen[name] = true
+			clusters = append(clusters, name)
+		}
+	}
+	for _, task := range spec.GracefulEvictionTasks {
+		add(task.FromCluster)
+		for _, c := range task.ClustersBeforeFailover {
+			add(c)
+		}
+	}
+	for _, c := range spec.Clusters {
+		add(c.Name)
+	}
+	return clusters
+}
+
+// eventEntries lists the events about the object kind/name in namespace, all namespaces if empty.
+func eventEntries(k8sClient kubernetes.Interface, namespace, name, kind string) ([]TimelineEntry, error) {
+	events, err := event.GetEvents(k8sClient, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]TimelineEntry, 0, len(events))
+	for i := range events {
+		e := &events[i]
+		if e.InvolvedObject.Name != name || !strings.EqualFold(e.InvolvedObject.Kind, kind) {
+			continue
+		}
+		entry := TimelineEntry{
+			Time:           eventTime(e),
+			Type:           TimelineEntryEvent,
+			Reason:         e.Reason,
+			Message:        e.Message,
+			EventType:      e.Type,
+			InvolvedObject: e.InvolvedObject.Kind + "/" + e.InvolvedObject.Name,
+		}
+		if e.InvolvedObject.Kind == "Cluster" {
+			entry.Cluster = e.InvolvedObject.Name
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// eventTime picks the most recent timestamp an event carries.
+func eventTime(e *corev1.Event) metav1.Time {
+	if !e.LastTimestamp.IsZero() {
+		return e.LastTimestamp
+	}
+	if !e.EventTime.IsZero() {
+		return metav1.NewTime(e.EventTime.Time)
+	}
+	return e.FirstTimestamp
+}
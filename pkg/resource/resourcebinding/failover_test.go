@@ -0,0 +1,90 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcebinding
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadafake "github.com/karmada-io/karmada/pkg/generated/clientset/versioned/fake"
+	corev1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestGetFailoverHistory(t *testing.T) {
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(minutes int) metaV1.Time { return metaV1.NewTime(base.Add(time.Duration(minutes) * time.Minute)) }
+	evictedAt := at(2)
+
+	karmadaClient := karmadafake.NewSimpleClientset(
+		&workv1alpha2.ResourceBinding{
+			ObjectMeta: metaV1.ObjectMeta{Name: "nginx-deployment", Namespace: "default"},
+			Spec: workv1alpha2.ResourceBindingSpec{
+				Resource: workv1alpha2.ObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "default", Name: "nginx"},
+				Clusters: []workv1alpha2.TargetCluster{{Name: "member2", Replicas: 2}},
+				GracefulEvictionTasks: []workv1alpha2.GracefulEvictionTask{{
+					FromCluster:            "member1",
+					Reason:                 workv1alpha2.EvictionReasonTaintUntolerated,
+					Producer:               workv1alpha2.EvictionProducerTaintManager,
+					CreationTimestamp:      &evictedAt,
+					ClustersBeforeFailover: []string{"member1"},
+				}},
+			},
+		},
+		&clusterv1alpha1.Cluster{
+			ObjectMeta: metaV1.ObjectMeta{Name: "member1"},
+			Status: clusterv1alpha1.ClusterStatus{Conditions: []metaV1.Condition{{
+				Type: clusterv1alpha1.ClusterConditionReady, Status: metaV1.ConditionFalse,
+				Reason: "ClusterNotReachable", LastTransitionTime: at(1),
+			}}},
+		},
+	)
+	k8sClient := k8sfake.NewSimpleClientset(
+		&corev1.Event{
+			ObjectMeta:     metaV1.ObjectMeta{Name: "nginx.1", Namespace: "default"},
+			InvolvedObject: corev1.ObjectReference{Kind: "Deployment", Namespace: "default", Name: "nginx"},
+			Reason:         "ScheduleBindingSucceed",
+			LastTimestamp:  at(3),
+		},
+	)
+
+	history, err := GetFailoverHistory(context.TODO(), karmadaClient, k8sClient, "default", "Deployment", "nginx")
+	if err != nil {
+		t.Fatalf("GetFailoverHistory() error = %v", err)
+	}
+
+	var gotTypes []string
+	for _, entry := range history.Timeline {
+		gotTypes = append(gotTypes, entry.Type)
+	}
+	wantTypes := []string{TimelineEntryClusterCondition, TimelineEntryEviction, TimelineEntryEvent}
+	if !reflect.DeepEqual(gotTypes, wantTypes) {
+		t.Fatalf("timeline types = %v, want %v", gotTypes, wantTypes)
+	}
+	if eviction := history.Timeline[1]; eviction.Cluster != "member1" || !reflect.DeepEqual(eviction.MovedTo, []string{"member2"}) {
+		t.Errorf("eviction entry = %+v, want from member1 moved to member2", eviction)
+	}
+	// member2 is scheduled but not registered in the fake client.
+	if len(history.Errors) != 1 {
+		t.Errorf("errors = %v, want the missing member2 cluster only", history.Errors)
+	}
+}
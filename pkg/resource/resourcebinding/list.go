@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcebinding
+
+import (
+	"context"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/common/helpers"
+	"github.com/karmada-io/dashboard/pkg/common/types"
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+	"github.com/karmada-io/dashboard/pkg/resource/common"
+)
+
+// ResourceBindingList contains a list of ResourceBindings in the karmada control-plane.
+type ResourceBindingList struct {
+	ListMeta types.ListMeta `json:"listMeta"`
+
+	// Unordered list of ResourceBindings.
+	ResourceBindings []ResourceBinding `json:"resourceBindings"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// ResourceBinding contains information about a single ResourceBinding.
+type ResourceBinding struct {
+	ObjectMeta types.ObjectMeta `json:"objectMeta"`
+	TypeMeta   types.TypeMeta   `json:"typeMeta"`
+	// Resource is the resource template the binding was created for.
+	Resource     workv1alpha2.ObjectReference `json:"resource"`
+	Replicas     int32                        `json:"replicas"`
+	Clusters     []workv1alpha2.TargetCluster `json:"clusters"`
+	Scheduled    metav1.ConditionStatus       `json:"scheduled"`
+	FullyApplied metav1.ConditionStatus       `json:"fullyApplied"`
+}
+
+// GetResourceBindingList returns a list of all ResourceBindings in the karmada control-plane.
+// Besides the usual properties, dsQuery can filter on cluster, scheduled, fullyApplied, resourceKind and resourceName.
+func GetResourceBindingList(client karmadaclientset.Interface, nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) (*ResourceBindingList, error) {
+	resourceBindings, err := client.WorkV1alpha2().ResourceBindings(nsQuery.ToRequestParam()).List(context.TODO(), helpers.ListEverything)
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	return toResourceBindingList(resourceBindings.Items, nonCriticalErrors, dsQuery), nil
+}
+
+func toResourceBindingList(resourceBindings []workv1alpha2.ResourceBinding, nonCriticalErrors []error, dsQuery *dataselect.DataSelectQuery) *ResourceBindingList {
+	resourceBindingList := &ResourceBindingList{
+		ResourceBindings: make([]ResourceBinding, 0),
+		ListMeta:         types.ListMeta{TotalItems: len(resourceBindings)},
+	}
+	resourceBindingCells, filteredTotal := dataselect.GenericDataSelectWithFilter(toCells(resourceBindings), dsQuery)
+	resourceBindings = fromCells(resourceBindingCells)
+	resourceBindingList.ListMeta = types.ListMeta{TotalItems: filteredTotal}
+	resourceBindingList.Errors = nonCriticalErrors
+
+	for i := range resourceBindings {
+		resourceBindingList.ResourceBindings = append(resourceBindingList.ResourceBindings, toResourceBinding(&resourceBindings[i]))
+	}
+	return resourceBindingList
+}
+
+func toResourceBinding(resourceBinding *workv1alpha2.ResourceBinding) ResourceBinding {
+	return ResourceBinding{
+		ObjectMeta:   types.NewObjectMeta(resourceBinding.ObjectMeta),
+		TypeMeta:     types.NewTypeMeta(types.ResourceKindResourceBinding),
+		Resource:     resourceBinding.Spec.Resource,
+		Replicas:     resourceBinding.Spec.Replicas,
+		Clusters:     resourceBinding.Spec.Clusters,
+		Scheduled:    ConditionStatus(resourceBinding.Status.Conditions, workv1alpha2.Scheduled),
+		FullyApplied: ConditionStatus(resourceBinding.Status.Conditions, workv1alpha2.FullyApplied),
+	}
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcebinding
+
+import (
+	"reflect"
+	"testing"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+)
+
+func newResourceBinding(name, kind string, clusters []string, scheduled metaV1.ConditionStatus) workv1alpha2.ResourceBinding {
+	rb := workv1alpha2.ResourceBinding{
+		ObjectMeta: metaV1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec: workv1alpha2.ResourceBindingSpec{
+			Resource: workv1alpha2.ObjectReference{APIVersion: "apps/v1", Kind: kind, Namespace: "default", Name: name},
+		},
+	}
+	for _, cluster := range clusters {
+		rb.Spec.Clusters = append(rb.Spec.Clusters, workv1alpha2.TargetCluster{Name: cluster})
+	}
+	if scheduled != "" {
+		rb.Status.Conditions = []metaV1.Condition{{Type: workv1alpha2.Scheduled, Status: scheduled}}
+	}
+	return rb
+}
+
+func TestToResourceBindingListFilter(t *testing.T) {
+	bindings := []workv1alpha2.ResourceBinding{
+		newResourceBinding("nginx-deployment", "Deployment", []string{"member1", "member2"}, metaV1.ConditionTrue),
+		newResourceBinding("redis-statefulset", "StatefulSet", []string{"member10"}, metaV1.ConditionFalse),
+		newResourceBinding("pending-deployment", "Deployment", nil, ""),
+	}
+
+	cases := []struct {
+		filterBy []string
+		expected []string
+	}{
+		{[]string{}, []string{"nginx-deployment", "redis-statefulset", "pending-deployment"}},
+		{[]string{dataselect.ClusterProperty, "member1"}, []string{"nginx-deployment"}},
+		{[]string{dataselect.ScheduledProperty, "True"}, []string{"nginx-deployment"}},
+		{[]string{dataselect.ScheduledProperty, "Unknown"}, []string{"pending-deployment"}},
+		{[]string{dataselect.ResourceKindProperty, "Deployment"}, []string{"nginx-deployment", "pending-deployment"}},
+		{[]string{dataselect.ResourceKindProperty, "Deployment", dataselect.ResourceNameProperty, "nginx"}, []string{"nginx-deployment"}},
+	}
+	for _, c := range cases {
+		dsQuery := dataselect.NewDataSelectQuery(dataselect.NoPagination, dataselect.NoSort, dataselect.NewFilterQuery(c.filterBy))
+		actual := toResourceBindingList(bindings, nil, dsQuery)
+		names := make([]string, 0, len(actual.ResourceBindings))
+		for _, rb := range actual.ResourceBindings {
+			names = append(names, rb.ObjectMeta.Name)
+		}
+		if !reflect.DeepEqual(names, c.expected) {
+			t.Errorf("toResourceBindingList() with filter %v == %v, expected %v", c.filterBy, names, c.expected)
+		}
+		if actual.ListMeta.TotalItems != len(c.expected) {
+			t.Errorf("toResourceBindingList() with filter %v has %d total items, expected %d", c.filterBy, actual.ListMeta.TotalItems, len(c.expected))
+		}
+	}
+}
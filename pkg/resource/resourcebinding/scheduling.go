@@ -0,0 +1,201 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcebinding
+
+import (
+	"context"
+	"math"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	karmadautil "github.com/karmada-io/karmada/pkg/util"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/karmada-io/dashboard/pkg/resource/cluster"
+	"github.com/karmada-io/dashboard/pkg/resource/propagationpolicy"
+)
+
+// ClusterVerdict explains how the scheduler sees a single candidate cluster for a binding.
+type ClusterVerdict struct {
+	Name            string `json:"name"`
+	AffinityMatched bool   `json:"affinityMatched"`
+	// UntoleratedTaints are the NoSchedule and NoExecute taints not tolerated by the binding.
+	UntoleratedTaints []corev1.Taint `json:"untoleratedTaints,omitempty"`
+	// APIEnabled tells whether the cluster reports the resource's group, version and kind in its API enablements.
+	APIEnabled bool `json:"apiEnabled"`
+	// AvailableReplicas is the number of replicas the cluster fits according to its resource summary,
+	// nil when the cluster does not report one.
+	AvailableReplicas  *int32                            `json:"availableReplicas,omitempty"`
+	AllocatedResources cluster.ClusterAllocatedResources `json:"allocatedResources"`
+	// Feasible tells whether the cluster passes the affinity, taint and API enablement filters.
+	Feasible bool `json:"feasible"`
+	// Scheduled tells whether the cluster is part of the binding's scheduling result.
+	Scheduled         bool  `json:"scheduled"`
+	ScheduledReplicas int32 `json:"scheduledReplicas"`
+}
+
+// SchedulingExplanation is the per-cluster reasoning behind the scheduling result of a ResourceBinding.
+type SchedulingExplanation struct {
+	Resource workv1alpha2.ObjectReference `json:"resource"`
+	// AffinityName is the ClusterAffinities term the scheduler last observed, empty without ClusterAffinities.
+	AffinityName      string                                     `json:"affinityName,omitempty"`
+	Clusters          []ClusterVerdict                           `json:"clusters"`
+	SpreadConstraints []propagationpolicy.SpreadConstraintResult `json:"spreadConstraints"`
+	// ScheduledClusters is the actual scheduling result, i.e. spec.clusters.
+	ScheduledClusters []workv1alpha2.TargetCluster `json:"scheduledClusters"`
+	// ScheduledCondition carries the scheduler's reason and message, nil before the first scheduling attempt.
+	ScheduledCondition *metav1.Condition `json:"scheduledCondition,omitempty"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// GetResourceBindingSchedulingExplanation evaluates every cluster against the placement of a ResourceBinding
+// and sets the verdicts next to the binding's actual scheduling result.
+func GetResourceBindingSchedulingExplanation(ctx context.Context, client karmadaclientset.Interface, namespace, name string) (*SchedulingExplanation, error) {
+	binding, err := client.WorkV1alpha2().ResourceBindings(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	clusters, err := client.ClusterV1alpha1().Clusters().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return explainScheduling(&binding.Spec, &binding.Status, clusters.Items), nil
+}
+
+func explainScheduling(spec *workv1alpha2.ResourceBindingSpec, status *workv1alpha2.ResourceBindingStatus,
+	clusters []clusterv1alpha1.Cluster) *SchedulingExplanation {
+	explanation := &SchedulingExplanation{
+		Resource:           spec.Resource,
+		AffinityName:       status.SchedulerObservedAffinityName,
+		Clusters:           make([]ClusterVerdict, 0, len(clusters)),
+		ScheduledClusters:  spec.Clusters,
+		ScheduledCondition: meta.FindStatusCondition(status.Conditions, workv1alpha2.Scheduled),
+		Errors:             []error{},
+	}
+	placement := policyv1alpha1.Placement{}
+	if spec.Placement != nil {
+		placement = *spec.Placement
+	}
+	affinity := observedAffinity(placement, status.SchedulerObservedAffinityName)
+	scheduled := make(map[string]int32, len(spec.Clusters))
+	for _, target := range spec.Clusters {
+		scheduled[target.Name] = target.Replicas
+	}
+
+	var feasible []*clusterv1alpha1.Cluster
+	for i := range clusters {
+		c := &clusters[i]
+		verdict := ClusterVerdict{
+			Name:              c.Name,
+			AffinityMatched:   karmadautil.ClusterMatches(c, affinity),
+			UntoleratedTaints: propagationpolicy.UntoleratedTaints(c.Spec.Taints, placement.ClusterTolerations),
+			APIEnabled:        apiEnabled(c, spec.Resource.APIVersion, spec.Resource.Kind),
+			AvailableReplicas: availableReplicas(c, spec.ReplicaRequirements),
+		}
+		verdict.AllocatedResources, _ = cluster.GetClusterAllocatedResources(c)
+		verdict.Feasible = verdict.AffinityMatched && len(verdict.UntoleratedTaints) == 0 && verdict.APIEnabled
+		verdict.ScheduledReplicas, verdict.Scheduled = scheduled[c.Name]
+		if verdict.Feasible {
+			feasible = append(feasible, c)
+		}
+		explanation.Clusters = append(explanation.Clusters, verdict)
+	}
+	explanation.SpreadConstraints = propagationpolicy.EvaluateSpreadConstraints(feasible, placement.SpreadConstraints)
+	return explanation
+}
+
+// observedAffinity returns the cluster affinity the scheduler evaluated: the ClusterAffinities term it
+// last observed, falling back to the first term, or the plain ClusterAffinity.
+func observedAffinity(placement policyv1alpha1.Placement, observedName string) policyv1alpha1.ClusterAffinity {
+	if len(placement.ClusterAffinities) == 0 {
+		if placement.ClusterAffinity != nil {
+			return *placement.ClusterAffinity
+		}
+		return policyv1alpha1.ClusterAffinity{}
+	}
+	for _, term := range placement.ClusterAffinities {
+		if term.AffinityName == observedName {
+			return term.ClusterAffinity
+		}
+	}
+	return placement.ClusterAffinities[0].ClusterAffinity
+}
+
+// apiEnabled reports whether the cluster serves kind in apiVersion, as the scheduler's APIEnablement plugin checks it.
+func apiEnabled(c *clusterv1alpha1.Cluster, apiVersion, kind string) bool {
+	gv, err := schema.ParseGroupVersion(apiVersion)
+	if err != nil {
+		return false
+	}
+	for _, enablement := range c.Status.APIEnablements {
+		if enablement.GroupVersion != gv.String() {
+			continue
+		}
+		for _, r := range enablement.Resources {
+			if r.Kind == kind {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// availableReplicas estimates how many replicas fit into the cluster's free resources, i.e. allocatable
+// minus allocated and allocating, with every replica taking one pod and its resource request.
+func availableReplicas(c *clusterv1alpha1.Cluster, requirements *workv1alpha2.ReplicaRequirements) *int32 {
+	summary := c.Status.ResourceSummary
+	if summary == nil {
+		return nil
+	}
+	request := corev1.ResourceList{corev1.ResourcePods: *resource.NewQuantity(1, resource.DecimalSI)}
+	if requirements != nil {
+		for name, quantity := range requirements.ResourceRequest {
+			request[name] = quantity
+		}
+	}
+
+	replicas := int64(math.MaxInt32)
+	for name, quantity := range request {
+		if quantity.IsZero() {
+			continue
+		}
+		free := summary.Allocatable[name].DeepCopy()
+		if allocated, ok := summary.Allocated[name]; ok {
+			free.Sub(allocated)
+		}
+		if allocating, ok := summary.Allocating[name]; ok {
+			free.Sub(allocating)
+		}
+		fits := int64(0)
+		if free.Sign() > 0 {
+			fits = free.MilliValue() / quantity.MilliValue()
+		}
+		if fits < replicas {
+			replicas = fits
+		}
+	}
+	result := int32(replicas)
+	return &result
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcebinding
+
+import (
+	"testing"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newSchedulingCluster(name string, cpu string, taints ...corev1.Taint) clusterv1alpha1.Cluster {
+	return clusterv1alpha1.Cluster{
+		ObjectMeta: metaV1.ObjectMeta{Name: name, Labels: map[string]string{"env": "prod"}},
+		Spec:       clusterv1alpha1.ClusterSpec{Taints: taints},
+		Status: clusterv1alpha1.ClusterStatus{
+			APIEnablements: []clusterv1alpha1.APIEnablement{{
+				GroupVersion: "apps/v1",
+				Resources:    []clusterv1alpha1.APIResource{{Name: "deployments", Kind: "Deployment"}},
+			}},
+			ResourceSummary: &clusterv1alpha1.ResourceSummary{
+				Allocatable: corev1.ResourceList{
+					corev1.ResourceCPU:  resource.MustParse(cpu),
+					corev1.ResourcePods: resource.MustParse("110"),
+				},
+				Allocated: corev1.ResourceList{
+					corev1.ResourceCPU:  resource.MustParse("1"),
+					corev1.ResourcePods: resource.MustParse("10"),
+				},
+			},
+		},
+	}
+}
+
+func TestExplainScheduling(t *testing.T) {
+	noAPI := newSchedulingCluster("member3", "4")
+	noAPI.Status.APIEnablements = nil
+	clusters := []clusterv1alpha1.Cluster{
+		newSchedulingCluster("member1", "4"),
+		newSchedulingCluster("member2", "8", corev1.Taint{Key: "maintenance", Effect: corev1.TaintEffectNoSchedule}),
+		noAPI,
+	}
+	spec := &workv1alpha2.ResourceBindingSpec{
+		Resource: workv1alpha2.ObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "default", Name: "nginx"},
+		ReplicaRequirements: &workv1alpha2.ReplicaRequirements{
+			ResourceRequest: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m")},
+		},
+		Placement: &policyv1alpha1.Placement{
+			ClusterAffinity: &policyv1alpha1.ClusterAffinity{
+				LabelSelector: &metaV1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+			},
+		},
+		Clusters: []workv1alpha2.TargetCluster{{Name: "member1", Replicas: 2}},
+	}
+
+	explanation := explainScheduling(spec, &workv1alpha2.ResourceBindingStatus{}, clusters)
+	if len(explanation.Clusters) != 3 {
+		t.Fatalf("explainScheduling() returned %d verdicts, want 3", len(explanation.Clusters))
+	}
+
+	member1, member2, member3 := explanation.Clusters[0], explanation.Clusters[1], explanation.Clusters[2]
+	if !member1.Feasible || !member1.Scheduled || member1.ScheduledReplicas != 2 {
+		t.Errorf("member1 verdict = %+v, want feasible and scheduled with 2 replicas", member1)
+	}
+	if member1.AvailableReplicas == nil || *member1.AvailableReplicas != 6 {
+		t.Errorf("member1 available replicas = %v, want 6", member1.AvailableReplicas)
+	}
+	if member2.Feasible || len(member2.UntoleratedTaints) != 1 {
+		t.Errorf("member2 verdict = %+v, want infeasible because of its taint", member2)
+	}
+	if member3.Feasible || member3.APIEnabled || !member3.AffinityMatched {
+		t.Errorf("member3 verdict = %+v, want infeasible because Deployment is not enabled", member3)
+	}
+	if member2.Scheduled || member3.Scheduled {
+		t.Errorf("only member1 should be reported as scheduled")
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"strings"
 	"sync"
 
@@ -32,6 +33,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
 	kubeclient "k8s.io/client-go/kubernetes"
 	"k8s.io/klog/v2"
 
@@ -39,53 +41,33 @@ import (
 	"github.com/karmada-io/dashboard/pkg/informer"
 )
 
-// getWorkload fetches the workload from the control plane, returns its UID and annotations.
-func getWorkload(ctx context.Context, k8sClient kubeclient.Interface, namespace, name, kind string) (types.UID, map[string]string, error) {
+// getWorkload fetches the resource template from the control plane, returns its UID and annotations.
+func getWorkload(ctx context.Context, dynamicClient dynamic.Interface, res *resourceInfo, namespace, name string) (types.UID, map[string]string, error) {
+	obj, err := getResourceTemplate(ctx, dynamicClient, res, namespace, name)
+	if err != nil {
+		return "", nil, err
+	}
+	return obj.GetUID(), obj.GetAnnotations(), nil
+}
+
+// hasPods reports whether pods of the given kind can be traced in member clusters.
+func hasPods(kind string) bool {
 	switch kind {
-	case "Deployment":
-		obj, err := k8sClient.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
-		if err != nil {
-			return "", nil, err
-		}
-		return obj.UID, obj.Annotations, nil
-	case "StatefulSet":
-		obj, err := k8sClient.AppsV1().StatefulSets(namespace).Get(ctx, name, metav1.GetOptions{})
-		if err != nil {
-			return "", nil, err
-		}
-		return obj.UID, obj.Annotations, nil
-	case "DaemonSet":
-		obj, err := k8sClient.AppsV1().DaemonSets(namespace).Get(ctx, name, metav1.GetOptions{})
-		if err != nil {
-			return "", nil, err
-		}
-		return obj.UID, obj.Annotations, nil
-	case "Job":
-		obj, err := k8sClient.BatchV1().Jobs(namespace).Get(ctx, name, metav1.GetOptions{})
-		if err != nil {
-			return "", nil, err
-		}
-		return obj.UID, obj.Annotations, nil
-	case "CronJob":
-		obj, err := k8sClient.BatchV1().CronJobs(namespace).Get(ctx, name, metav1.GetOptions{})
-		if err != nil {
-			return "", nil, err
-		}
-		return obj.UID, obj.Annotations, nil
+	case "Deployment", "StatefulSet", "DaemonSet", "Job", "CronJob":
+		return true
 	default:
-		return "", nil, fmt.Errorf("unsupported workload kind: %s", kind)
+		return false
 	}
 }
 
 // getPropagationPolicy reads PP/CPP annotations from the workload and returns a ref.
 func getPropagationPolicy(annotations map[string]string) *PropagationPolicyRef {
-	klog.Infof("[topology] getPropagationPolicy annotations=%v", annotations)
 	if ppName := annotations[policyv1alpha1.PropagationPolicyNameAnnotation]; ppName != "" {
 		ref := &PropagationPolicyRef{
 			Name:      ppName,
 			Namespace: annotations[policyv1alpha1.PropagationPolicyNamespaceAnnotation],
 		}
-		klog.Infof("[topology] found PP ref: %+v", ref)
+		klog.V(4).InfoS("Found PropagationPolicy of resource template", "namespace", ref.Namespace, "name", ref.Name)
 		return ref
 	}
 	if cppName := annotations[policyv1alpha1.ClusterPropagationPolicyAnnotation]; cppName != "" {
@@ -93,10 +75,9 @@ func getPropagationPolicy(annotations map[string]string) *PropagationPolicyRef {
 			Name:           cppName,
 			IsClusterScope: true,
 		}
-		klog.Infof("[topology] found CPP ref: %+v", ref)
+		klog.V(4).InfoS("Found ClusterPropagationPolicy of resource template", "name", ref.Name)
 		return ref
 	}
-	klog.Infof("[topology] no PP/CPP annotation found")
 	return nil
 }
 
@@ -126,6 +107,81 @@ func getWorksByRBName(rbName string) ([]*workv1alpha1.Work, error) {
 	return works, nil
 }
 
+// getClusterResourceBindings looks up ClusterResourceBindings by workload UID via informer indexer.
+func getClusterResourceBindings(uid types.UID) ([]*workv1alpha2.ClusterResourceBinding, error) {
+	items, err := informer.ClusterResourceBindingIndexer().ByIndex(informer.ClusterResourceBindingByOwnerUID, string(uid))
+	if err != nil {
+		return nil, fmt.Errorf("indexer query clusterresourcebindings: %w", err)
+	}
+	var crbs []*workv1alpha2.ClusterResourceBinding
+	for _, item := range items {
+		crbs = append(crbs, item.(*workv1alpha2.ClusterResourceBinding))
+	}
+	return crbs, nil
+}
+
+// getWorksByCRBName looks up Works by ClusterResourceBinding name via informer indexer.
+func getWorksByCRBName(crbName string) ([]*workv1alpha1.Work, error) {
+	items, err := informer.WorkIndexer().ByIndex(informer.WorkByCRBName, crbName)
+	if err != nil {
+		return nil, fmt.Errorf("indexer query works: %w", err)
+	}
+	var works []*workv1alpha1.Work
+	for _, item := range items {
+		works = append(works, item.(*workv1alpha1.Work))
+	}
+	return works, nil
+}
+
+// binding is the ResourceBinding or ClusterResourceBinding a resource template is scheduled through.
+type binding struct {
+	NodeID    string
+	Type      NodeType
+	Name      string
+	Namespace string
+}
+
+// getBindings returns the bindings of a resource template. Namespace-scoped templates are bound by
+// ResourceBindings, cluster-scoped templates by ClusterResourceBindings.
+func getBindings(res *resourceInfo, uid types.UID) ([]binding, error) {
+	var bindings []binding
+	if !res.Namespaced {
+		crbs, err := getClusterResourceBindings(uid)
+		if err != nil {
+			return nil, err
+		}
+		for _, crb := range crbs {
+			bindings = append(bindings, binding{
+				NodeID: fmt.Sprintf("crb-%s", crb.UID),
+				Type:   NodeTypeClusterResourceBinding,
+				Name:   crb.Name,
+			})
+		}
+		return bindings, nil
+	}
+	rbs, err := getResourceBindings(uid)
+	if err != nil {
+		return nil, err
+	}
+	for _, rb := range rbs {
+		bindings = append(bindings, binding{
+			NodeID:    fmt.Sprintf("rb-%s", rb.UID),
+			Type:      NodeTypeResourceBinding,
+			Name:      rb.Name,
+			Namespace: rb.Namespace,
+		})
+	}
+	return bindings, nil
+}
+
+// getWorks returns the Works created for a binding.
+func (b binding) getWorks() ([]*workv1alpha1.Work, error) {
+	if b.Type == NodeTypeClusterResourceBinding {
+		return getWorksByCRBName(b.Name)
+	}
+	return getWorksByRBName(b.Name)
+}
+
 // selectorLabels carries labels usable to narrow down API list calls for pods or intermediate resources.
 type selectorLabels map[string]string
 
@@ -245,10 +301,10 @@ func filterPodsByOwners(pods []corev1.Pod, ownerUIDs []types.UID) []*corev1.Pod
 // getPodsByWorkUID fetches Pods from member cluster that belong to the workload.
 // It uses label selectors from the workload spec to narrow the list call at API level,
 // then filters by ownerReference as a safety net.
-func getPodsByWorkUID(ctx context.Context, clusterName, namespace, name, kind string) ([]*corev1.Pod, error) {
-	memberClient := client.InClusterClientForMemberCluster(clusterName)
-	if memberClient == nil {
-		return nil, fmt.Errorf("unable to get client for member cluster %s", clusterName)
+func getPodsByWorkUID(ctx context.Context, request *http.Request, clusterName, namespace, name, kind string) ([]*corev1.Pod, error) {
+	memberClient, err := client.ClientForMemberClusterFromRequest(request, clusterName)
+	if err != nil {
+		return nil, fmt.Errorf("get client for member cluster %s: %w", clusterName, err)
 	}
 	workloadUID, labels, err := getMemberWorkloadInfo(ctx, memberClient, namespace, name, kind)
 	if err != nil {
@@ -303,9 +359,9 @@ func getPodStatus(pod *corev1.Pod) NodeStatus {
 	}
 }
 
-// parseOverridePolicies extracts applied override policy refs from Work annotations.
+// ParseOverridePolicies extracts applied override policy refs from Work annotations.
 // workloadNamespace is used as the namespace for namespace-scoped OverridePolicies.
-func parseOverridePolicies(annotations map[string]string, workloadNamespace string) []OverridePolicyRef {
+func ParseOverridePolicies(annotations map[string]string, workloadNamespace string) []OverridePolicyRef {
 	var refs []OverridePolicyRef
 	var entries []struct {
 		PolicyName string `json:"policyName"`
@@ -338,13 +394,15 @@ func clusterNameFromWorkNamespace(ns string) string {
 }
 
 // getMemberWorkloadStatus fetches the member cluster workload and returns its health status.
-func getMemberWorkloadStatus(ctx context.Context, clusterName, namespace, name, kind string) NodeStatus {
-	memberClient := client.InClusterClientForMemberCluster(clusterName)
-	if memberClient == nil {
-		return NodeStatusAbnormal
-	}
-	switch kind {
+// Kinds without a known readiness signal are healthy as long as they exist in the member cluster.
+func getMemberWorkloadStatus(ctx context.Context, request *http.Request, clusterName string, res *resourceInfo, namespace, name string) NodeStatus {
+	switch res.Kind {
 	case "Deployment":
+		memberClient, err := client.ClientForMemberClusterFromRequest(request, clusterName)
+		if err != nil {
+			klog.V(4).InfoS("Failed to get member cluster client", "cluster", clusterName, "err", err)
+			return NodeStatusAbnormal
+		}
 		deploy, err := memberClient.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
 		if err != nil {
 			klog.V(4).InfoS("Failed to get member deployment", "cluster", clusterName, "err", err)
@@ -355,20 +413,33 @@ func getMemberWorkloadStatus(ctx context.Context, clusterName, namespace, name,
 		}
 		return NodeStatusProgressing
 	default:
+		memberClient, err := client.DynamicClientForMemberClusterFromRequest(request, clusterName)
+		if err != nil {
+			klog.V(4).InfoS("Failed to get member cluster client", "cluster", clusterName, "err", err)
+			return NodeStatusAbnormal
+		}
+		if _, err := getResourceTemplate(ctx, memberClient, res, namespace, name); err != nil {
+			klog.V(4).InfoS("Failed to get member resource", "cluster", clusterName, "resource", res.GVR.String(), "err", err)
+			return NodeStatusAbnormal
+		}
 		return NodeStatusHealthy
 	}
 }
 
 // traceChain traces the full propagation chain from a control-plane workload.
+// Member cluster objects are read with the credentials of request.
 func traceChain(
 	ctx context.Context,
-	k8sClient kubeclient.Interface,
-	namespace, name, kind string,
+	request *http.Request,
+	dynamicClient dynamic.Interface,
+	res *resourceInfo,
+	namespace, name string,
 ) (*TopologyResponse, error) {
 	resp := &TopologyResponse{}
+	kind := res.Kind
 
 	// Step 1: Get workload
-	uid, annotations, err := getWorkload(ctx, k8sClient, namespace, name, kind)
+	uid, annotations, err := getWorkload(ctx, dynamicClient, res, namespace, name)
 	if err != nil {
 		return nil, fmt.Errorf("get workload: %w", err)
 	}
@@ -383,19 +454,19 @@ func traceChain(
 		Status:    NodeStatusHealthy,
 	})
 
-	// Step 2: Get ResourceBindings via indexer
-	rbs, err := getResourceBindings(uid)
+	// Step 2: Get ResourceBindings or ClusterResourceBindings via indexer
+	bindings, err := getBindings(res, uid)
 	if err != nil {
 		klog.V(4).InfoS("Failed to get resource bindings", "uid", uid, "err", err)
 		return resp, nil
 	}
-	for _, rb := range rbs {
-		rbNodeID := fmt.Sprintf("rb-%s", rb.UID)
+	for _, b := range bindings {
+		rbNodeID := b.NodeID
 		resp.Nodes = append(resp.Nodes, TopologyNode{
 			ID:        rbNodeID,
-			Type:      NodeTypeResourceBinding,
-			Name:      rb.Name,
-			Namespace: rb.Namespace,
+			Type:      b.Type,
+			Name:      b.Name,
+			Namespace: b.Namespace,
 			Status:    NodeStatusHealthy,
 		})
 		ppLabel := ""
@@ -407,14 +478,13 @@ func traceChain(
 			}
 			ppLabel = fmt.Sprintf("%s: %s", prefix, ppRef.Name)
 			ppEdgeData = &TopologyEdgeData{PropagationPolicy: ppRef}
-			klog.Infof("[topology] ppRef=%+v ppEdgeData=%+v", ppRef, ppEdgeData)
 		}
 		resp.Edges = append(resp.Edges, TopologyEdge{Source: rtNodeID, Target: rbNodeID, Label: ppLabel, Data: ppEdgeData})
 
 		// Step 3: Get Works via indexer
-		works, err := getWorksByRBName(rb.Name)
+		works, err := b.getWorks()
 		if err != nil {
-			klog.V(4).InfoS("Failed to get works", "rb", rb.Name, "err", err)
+			klog.V(4).InfoS("Failed to get works", "binding", b.Name, "err", err)
 			continue
 		}
 
@@ -426,8 +496,8 @@ func traceChain(
 			g.Go(func() error {
 				clusterName := clusterNameFromWorkNamespace(w.Namespace)
 				workNodeID := fmt.Sprintf("work-%s", w.UID)
-				overrides := parseOverridePolicies(w.Annotations, namespace)
-				memberStatus := getMemberWorkloadStatus(gCtx, clusterName, namespace, name, kind)
+				overrides := ParseOverridePolicies(w.Annotations, namespace)
+				memberStatus := getMemberWorkloadStatus(gCtx, request, clusterName, res, namespace, name)
 				memberNodeID := fmt.Sprintf("member-%s-%s", clusterName, name)
 
 				mu.Lock()
@@ -467,7 +537,10 @@ func traceChain(
 				mu.Unlock()
 
 				// Step 5: Get Pods in member cluster
-				pods, err := getPodsByWorkUID(ctx, clusterName, namespace, name, kind)
+				if !hasPods(kind) {
+					return nil
+				}
+				pods, err := getPodsByWorkUID(ctx, request, clusterName, namespace, name, kind)
 				if err != nil {
 					klog.V(4).InfoS("Failed to get pods", "work", w.Name, "cluster", clusterName, "err", err)
 					return nil
@@ -0,0 +1,172 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topology
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	kubeclient "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/restmapper"
+
+	"github.com/karmada-io/dashboard/pkg/client"
+)
+
+// resourceInfo describes a resource template kind resolved through discovery.
+type resourceInfo struct {
+	GVR        schema.GroupVersionResource
+	Kind       string
+	Namespaced bool
+}
+
+// kindResolver resolves template kinds through a RESTMapper and the cached discovery backing it.
+type kindResolver struct {
+	discovery discovery.CachedDiscoveryInterface
+	mapper    meta.ResettableRESTMapper
+}
+
+func newKindResolver(discoveryClient discovery.DiscoveryInterface) *kindResolver {
+	cached := memory.NewMemCacheClient(discoveryClient)
+	return &kindResolver{discovery: cached, mapper: restmapper.NewDeferredDiscoveryRESTMapper(cached)}
+}
+
+var (
+	// sharedResolver resolves template kinds for all requests. Discovery runs on the first lookup and again
+	// only for kinds it does not know, e.g. of a CRD installed since. The kinds served do not depend on the
+	// caller, so it discovers with the dashboard's credentials.
+	sharedResolver     *kindResolver
+	sharedResolverOnce sync.Once
+)
+
+// kindResolverFor returns the shared kindResolver, or one discovering with k8sClient if the dashboard's own
+// client is not initialized.
+func kindResolverFor(k8sClient kubeclient.Interface) *kindResolver {
+	sharedResolverOnce.Do(func() {
+		if inClusterClient := client.InClusterClientForKarmadaAPIServer(); inClusterClient != nil {
+			sharedResolver = newKindResolver(inClusterClient.Discovery())
+		}
+	})
+	if sharedResolver != nil {
+		return sharedResolver
+	}
+	return newKindResolver(k8sClient.Discovery())
+}
+
+// resolveResource maps the kind from the request path to a GroupVersionResource.
+// kind is either a plain Kind such as "Deployment" (matched case-insensitively) or
+// "Kind.group" to pick a CRD whose Kind is also served by another group; a plain
+// Kind served by several groups is refused.
+// When a Kind is served in several versions, the group's preferred version wins.
+func resolveResource(r *kindResolver, kind string) (*resourceInfo, error) {
+	kindName, group, _ := strings.Cut(kind, ".")
+	// the mapper knows kinds by their singular resource name, which is the lower-cased Kind
+	// unless a CRD declares another singular; those are found by scanning discovery below
+	resource := schema.GroupVersionResource{Group: group, Resource: strings.ToLower(kindName)}
+	gvks, err := r.mapper.KindsFor(resource)
+	if meta.IsNoMatchError(err) {
+		// the cached discovery may predate the kind
+		r.mapper.Reset()
+		gvks, err = r.mapper.KindsFor(resource)
+	}
+	if meta.IsNoMatchError(err) {
+		gvks, err = r.kindsByName(kindName, group)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not find resource for kind %s: %w", kind, err)
+	}
+	if len(gvks) == 0 {
+		return nil, fmt.Errorf("could not find resource for kind %s", kind)
+	}
+	groups := sets.New[string]()
+	for _, gvk := range gvks {
+		groups.Insert(gvk.Group)
+	}
+	if groups.Len() > 1 {
+		return nil, fmt.Errorf("kind %s is served by the groups %s, qualify it as %s.<group>",
+			kind, strings.Join(sets.List(groups), ", "), kindName)
+	}
+	// kinds are sorted by priority, the preferred version first
+	mapping, err := r.mapper.RESTMapping(gvks[0].GroupKind(), gvks[0].Version)
+	if err != nil {
+		return nil, fmt.Errorf("could not find resource for kind %s: %w", kind, err)
+	}
+	return &resourceInfo{
+		GVR:        mapping.Resource,
+		Kind:       mapping.GroupVersionKind.Kind,
+		Namespaced: mapping.Scope.Name() == meta.RESTScopeNameNamespace,
+	}, nil
+}
+
+// kindsByName returns the kinds in discovery whose name matches kindName case-insensitively, limited to group
+// if it is set. Versions are left out so that RESTMapping picks the group's preferred one.
+func (r *kindResolver) kindsByName(kindName, group string) ([]schema.GroupVersionKind, error) {
+	_, lists, err := r.discovery.ServerGroupsAndResources()
+	if err != nil && len(lists) == 0 {
+		return nil, err
+	}
+	kinds := sets.New[schema.GroupKind]()
+	for _, list := range lists {
+		gv, err := schema.ParseGroupVersion(list.GroupVersion)
+		if err != nil || (group != "" && gv.Group != group) {
+			continue
+		}
+		for _, res := range list.APIResources {
+			if !strings.Contains(res.Name, "/") && strings.EqualFold(res.Kind, kindName) {
+				kinds.Insert(schema.GroupKind{Group: gv.Group, Kind: res.Kind})
+			}
+		}
+	}
+	gvks := make([]schema.GroupVersionKind, 0, kinds.Len())
+	for gk := range kinds {
+		gvks = append(gvks, gk.WithVersion(""))
+	}
+	return gvks, nil
+}
+
+// resolveTemplateResource resolves kind and returns the namespace the template lives in.
+// The namespace is dropped for cluster-scoped kinds and required for namespace-scoped ones.
+func resolveTemplateResource(r *kindResolver, kind, namespace string) (*resourceInfo, string, error) {
+	res, err := resolveResource(r, kind)
+	if err != nil {
+		return nil, "", fmt.Errorf("resolve kind: %w", err)
+	}
+	if !res.Namespaced {
+		return res, "", nil
+	}
+	if namespace == "" {
+		return nil, "", fmt.Errorf("kind %s is namespace-scoped, namespace is required", res.Kind)
+	}
+	return res, namespace, nil
+}
+
+// getResourceTemplate fetches the resource template from the control plane through the dynamic client.
+func getResourceTemplate(ctx context.Context, dynamicClient dynamic.Interface, res *resourceInfo, namespace, name string) (*unstructured.Unstructured, error) {
+	if !res.Namespaced {
+		namespace = ""
+	}
+	return dynamicClient.Resource(res.GVR).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topology
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+func newFakeResolver() *kindResolver {
+	discoveryClient := &fakediscovery.FakeDiscovery{Fake: &clienttesting.Fake{}}
+	discoveryClient.Resources = []*metav1.APIResourceList{
+		{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{Name: "services", Kind: "Service", Namespaced: true},
+				{Name: "services/status", Kind: "Service", Namespaced: true},
+				{Name: "namespaces", Kind: "Namespace"},
+			},
+		},
+		{
+			GroupVersion: "apps/v1",
+			APIResources: []metav1.APIResource{
+				{Name: "deployments", Kind: "Deployment", Namespaced: true},
+			},
+		},
+		{
+			GroupVersion: "example.io/v1",
+			APIResources: []metav1.APIResource{
+				{Name: "widgets", Kind: "Widget", Namespaced: true},
+				{Name: "sprockets", SingularName: "spr", Kind: "Sprocket", Namespaced: true},
+			},
+		},
+		{
+			GroupVersion: "example.io/v1beta1",
+			APIResources: []metav1.APIResource{
+				{Name: "widgets", Kind: "Widget", Namespaced: true},
+			},
+		},
+		{
+			GroupVersion: "other.io/v1",
+			APIResources: []metav1.APIResource{
+				{Name: "widgets", Kind: "Widget", Namespaced: true},
+			},
+		},
+	}
+	return newKindResolver(discoveryClient)
+}
+
+func TestResolveResource(t *testing.T) {
+	resolver := newFakeResolver()
+
+	testCases := []struct {
+		name           string
+		kind           string
+		wantGVR        schema.GroupVersionResource
+		wantNamespaced bool
+		expectError    bool
+	}{
+		{
+			name:           "built-in workload",
+			kind:           "Deployment",
+			wantGVR:        schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"},
+			wantNamespaced: true,
+		},
+		{
+			name:           "case-insensitive kind",
+			kind:           "service",
+			wantGVR:        schema.GroupVersionResource{Version: "v1", Resource: "services"},
+			wantNamespaced: true,
+		},
+		{
+			name:    "cluster-scoped kind",
+			kind:    "Namespace",
+			wantGVR: schema.GroupVersionResource{Version: "v1", Resource: "namespaces"},
+		},
+		{
+			name:           "custom resource prefers preferred version",
+			kind:           "Widget.example.io",
+			wantGVR:        schema.GroupVersionResource{Group: "example.io", Version: "v1", Resource: "widgets"},
+			wantNamespaced: true,
+		},
+		{
+			name:           "custom resource qualified by group",
+			kind:           "Widget.other.io",
+			wantGVR:        schema.GroupVersionResource{Group: "other.io", Version: "v1", Resource: "widgets"},
+			wantNamespaced: true,
+		},
+		{
+			name:           "custom resource with its own singular name",
+			kind:           "sprocket",
+			wantGVR:        schema.GroupVersionResource{Group: "example.io", Version: "v1", Resource: "sprockets"},
+			wantNamespaced: true,
+		},
+		{
+			name:           "custom resource with its own singular name qualified by group",
+			kind:           "Sprocket.example.io",
+			wantGVR:        schema.GroupVersionResource{Group: "example.io", Version: "v1", Resource: "sprockets"},
+			wantNamespaced: true,
+		},
+		{
+			name:        "custom resource with its own singular name in another group",
+			kind:        "Sprocket.other.io",
+			expectError: true,
+		},
+		{
+			name:        "kind served by several groups",
+			kind:        "Widget",
+			expectError: true,
+		},
+		{
+			name:        "unknown kind",
+			kind:        "Gadget",
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := resolveResource(resolver, tc.kind)
+			if (err != nil) != tc.expectError {
+				t.Fatalf("resolveResource() error = %v, expectError %v", err, tc.expectError)
+			}
+			if tc.expectError {
+				return
+			}
+			if res.GVR != tc.wantGVR {
+				t.Errorf("resolveResource() GVR = %v, want %v", res.GVR, tc.wantGVR)
+			}
+			if res.Namespaced != tc.wantNamespaced {
+				t.Errorf("resolveResource() Namespaced = %v, want %v", res.Namespaced, tc.wantNamespaced)
+			}
+		})
+	}
+}
+
+func TestResolveTemplateResource(t *testing.T) {
+	resolver := newFakeResolver()
+
+	testCases := []struct {
+		name          string
+		kind          string
+		namespace     string
+		wantNamespace string
+		expectError   bool
+	}{
+		{
+			name:          "namespace-scoped kind keeps namespace",
+			kind:          "Deployment",
+			namespace:     "default",
+			wantNamespace: "default",
+		},
+		{
+			name:        "namespace-scoped kind requires namespace",
+			kind:        "Deployment",
+			expectError: true,
+		},
+		{
+			name:          "cluster-scoped kind drops namespace",
+			kind:          "Namespace",
+			namespace:     "default",
+			wantNamespace: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, namespace, err := resolveTemplateResource(resolver, tc.kind, tc.namespace)
+			if (err != nil) != tc.expectError {
+				t.Fatalf("resolveTemplateResource() error = %v, expectError %v", err, tc.expectError)
+			}
+			if namespace != tc.wantNamespace {
+				t.Errorf("resolveTemplateResource() namespace = %q, want %q", namespace, tc.wantNamespace)
+			}
+		})
+	}
+}
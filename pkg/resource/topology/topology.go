@@ -18,14 +18,26 @@ package topology
 
 import (
 	"context"
+	"net/http"
 
+	"k8s.io/client-go/dynamic"
 	kubeclient "k8s.io/client-go/kubernetes"
 )
 
 // GetResourceTopology traces the full propagation topology for a given resource.
+// kind may be any resource kind served by the Karmada apiserver, including CRDs,
+// optionally qualified with its group as "Kind.group". namespace is ignored for
+// cluster-scoped kinds, which are traced through ClusterResourceBindings.
+// Member cluster objects are read with the credentials of request.
 func GetResourceTopology(
 	ctx context.Context,
+	request *http.Request,
 	k8sClient kubeclient.Interface,
+	dynamicClient dynamic.Interface,
 	namespace, name, kind string) (*TopologyResponse, error) {
-	return traceChain(ctx, k8sClient, namespace, name, kind)
+	res, namespace, err := resolveTemplateResource(kindResolverFor(k8sClient), kind, namespace)
+	if err != nil {
+		return nil, err
+	}
+	return traceChain(ctx, request, dynamicClient, res, namespace, name)
 }
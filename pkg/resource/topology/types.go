@@ -21,11 +21,12 @@ type NodeType string
 
 // NodeType constants define the possible types of topology nodes.
 const (
-	NodeTypeResourceTemplate      NodeType = "ResourceTemplate"
-	NodeTypeResourceBinding       NodeType = "ResourceBinding"
-	NodeTypeWork                  NodeType = "Work"
-	NodeTypeMemberClusterWorkload NodeType = "MemberClusterWorkload"
-	NodeTypePod                   NodeType = "Pod"
+	NodeTypeResourceTemplate       NodeType = "ResourceTemplate"
+	NodeTypeResourceBinding        NodeType = "ResourceBinding"
+	NodeTypeClusterResourceBinding NodeType = "ClusterResourceBinding"
+	NodeTypeWork                   NodeType = "Work"
+	NodeTypeMemberClusterWorkload  NodeType = "MemberClusterWorkload"
+	NodeTypePod                    NodeType = "Pod"
 )
 
 // NodeStatus represents the health status of a topology node.
@@ -107,3 +108,21 @@ type PodNodeData struct {
 	Ready bool   `json:"ready"`
 	Phase string `json:"phase"`
 }
+
+// DeltaType is the kind of change carried by a TopologyDelta.
+type DeltaType string
+
+// DeltaType constants define the possible changes of a node or edge in a topology watch stream.
+const (
+	DeltaTypeAdd    DeltaType = "add"
+	DeltaTypeUpdate DeltaType = "update"
+	DeltaTypeRemove DeltaType = "remove"
+)
+
+// TopologyDelta is a single node or edge change emitted by the topology watch stream.
+// Exactly one of Node and Edge is set.
+type TopologyDelta struct {
+	Type DeltaType     `json:"type"`
+	Node *TopologyNode `json:"node,omitempty"`
+	Edge *TopologyEdge `json:"edge,omitempty"`
+}
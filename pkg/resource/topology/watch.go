@@ -0,0 +1,376 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topology
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"time"
+
+	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+	kubeclient "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/informer"
+)
+
+const (
+	// rebuildDebounce coalesces bursts of informer and watch events into a single rebuild.
+	rebuildDebounce = 500 * time.Millisecond
+	// watchRetryPeriod is the delay before a closed or failed watch is re-established.
+	watchRetryPeriod = 5 * time.Second
+)
+
+// topologyWatcher keeps the topology graph of one resource template and turns rebuilds into deltas.
+type topologyWatcher struct {
+	request       *http.Request
+	dynamicClient dynamic.Interface
+	res           *resourceInfo
+	namespace     string
+	name          string
+
+	trigger chan struct{}
+
+	nodes   map[string]TopologyNode
+	edges   map[string]TopologyEdge
+	members map[string]context.CancelFunc
+}
+
+// WatchResourceTopology streams topology deltas of a resource template until ctx is cancelled.
+// The first call to emit carries the full graph as additions, later calls carry only changes.
+// Rebuilds are driven by the (Cluster)ResourceBinding and Work informers, a watch on the template in the
+// control plane, and watches on the workload and its pods in every member cluster it landed in.
+// Member cluster objects are read and watched with the credentials of request.
+func WatchResourceTopology(
+	ctx context.Context,
+	request *http.Request,
+	k8sClient kubeclient.Interface,
+	dynamicClient dynamic.Interface,
+	namespace, name, kind string,
+	emit func([]TopologyDelta) error) error {
+	res, namespace, err := resolveTemplateResource(kindResolverFor(k8sClient), kind, namespace)
+	if err != nil {
+		return err
+	}
+	if _, err := getResourceTemplate(ctx, dynamicClient, res, namespace, name); err != nil {
+		return fmt.Errorf("get workload: %w", err)
+	}
+
+	w := &topologyWatcher{
+		request:       request,
+		dynamicClient: dynamicClient,
+		res:           res,
+		namespace:     namespace,
+		name:          name,
+		trigger:       make(chan struct{}, 1),
+		nodes:         map[string]TopologyNode{},
+		edges:         map[string]TopologyEdge{},
+		members:       map[string]context.CancelFunc{},
+	}
+	defer w.stopMemberWatches()
+
+	bindingInformer := informer.ResourceBindingInformer()
+	if !res.Namespaced {
+		bindingInformer = informer.ClusterResourceBindingInformer()
+	}
+	bindingRegistration, err := bindingInformer.AddEventHandler(w.eventHandler(w.isOwnBinding))
+	if err != nil {
+		return fmt.Errorf("add binding event handler: %w", err)
+	}
+	defer func() {
+		_ = bindingInformer.RemoveEventHandler(bindingRegistration)
+	}()
+	workRegistration, err := informer.WorkInformer().AddEventHandler(w.eventHandler(w.isOwnWork))
+	if err != nil {
+		return fmt.Errorf("add work event handler: %w", err)
+	}
+	defer func() {
+		_ = informer.WorkInformer().RemoveEventHandler(workRegistration)
+	}()
+	go w.runWatch(ctx, func(ctx context.Context) (watch.Interface, error) {
+		return dynamicClient.Resource(res.GVR).Namespace(namespace).Watch(ctx, nameListOptions(name))
+	})
+
+	w.notify()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-w.trigger:
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(rebuildDebounce):
+		}
+
+		resp, err := traceChain(ctx, request, dynamicClient, res, namespace, name)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			if apierrors.IsNotFound(err) {
+				// The template is gone, remove everything and end the stream.
+				return emit(w.apply(&TopologyResponse{}))
+			}
+			klog.V(4).InfoS("Failed to rebuild topology", "kind", res.Kind, "namespace", namespace, "name", name, "err", err)
+			continue
+		}
+		deltas := w.apply(resp)
+		w.syncMemberWatches(ctx, resp)
+		if len(deltas) == 0 {
+			continue
+		}
+		if err := emit(deltas); err != nil {
+			return err
+		}
+	}
+}
+
+// notify schedules a rebuild without blocking; pending notifications are coalesced.
+func (w *topologyWatcher) notify() {
+	select {
+	case w.trigger <- struct{}{}:
+	default:
+	}
+}
+
+// eventHandler returns an informer event handler that schedules a rebuild for objects accepted by filter.
+func (w *topologyWatcher) eventHandler(filter func(obj interface{}) bool) cache.ResourceEventHandler {
+	return cache.FilteringResourceEventHandler{
+		FilterFunc: func(obj interface{}) bool {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			return filter(obj)
+		},
+		Handler: cache.ResourceEventHandlerFuncs{
+			AddFunc:    func(interface{}) { w.notify() },
+			UpdateFunc: func(interface{}, interface{}) { w.notify() },
+			DeleteFunc: func(interface{}) { w.notify() },
+		},
+	}
+}
+
+// isOwnBinding reports whether obj is a ResourceBinding or ClusterResourceBinding of the watched template.
+// Bindings are matched by the resource they reference rather than by owner UID, so that a template that is
+// deleted and recreated under the same name is still followed.
+func (w *topologyWatcher) isOwnBinding(obj interface{}) bool {
+	var ref workv1alpha2.ObjectReference
+	switch b := obj.(type) {
+	case *workv1alpha2.ResourceBinding:
+		ref = b.Spec.Resource
+	case *workv1alpha2.ClusterResourceBinding:
+		ref = b.Spec.Resource
+	default:
+		return false
+	}
+	gvk := schema.FromAPIVersionAndKind(ref.APIVersion, ref.Kind)
+	return gvk.Group == w.res.GVR.Group && gvk.Kind == w.res.Kind &&
+		ref.Namespace == w.namespace && ref.Name == w.name
+}
+
+// isOwnWork reports whether obj is a Work created for a binding owned by the watched template.
+func (w *topologyWatcher) isOwnWork(obj interface{}) bool {
+	work, ok := obj.(*workv1alpha1.Work)
+	if !ok {
+		return false
+	}
+	if crbName := work.Annotations[workv1alpha2.ClusterResourceBindingAnnotationKey]; crbName != "" {
+		item, exists, err := informer.ClusterResourceBindingIndexer().GetByKey(crbName)
+		if err != nil || !exists {
+			return false
+		}
+		return w.isOwnBinding(item)
+	}
+	rbName := work.Annotations[workv1alpha2.ResourceBindingNameAnnotationKey]
+	if rbName == "" {
+		return false
+	}
+	key := rbName
+	if rbNamespace := work.Annotations[workv1alpha2.ResourceBindingNamespaceAnnotationKey]; rbNamespace != "" {
+		key = rbNamespace + "/" + rbName
+	}
+	item, exists, err := informer.ResourceBindingIndexer().GetByKey(key)
+	if err != nil || !exists {
+		// The binding may already be gone while its Works are being cleaned up.
+		return false
+	}
+	return w.isOwnBinding(item)
+}
+
+// runWatch keeps a watch open until ctx is cancelled and schedules a rebuild for every event.
+func (w *topologyWatcher) runWatch(ctx context.Context, start func(ctx context.Context) (watch.Interface, error)) {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		watcher, err := start(ctx)
+		if err != nil {
+			klog.V(4).InfoS("Failed to start topology watch", "err", err)
+			return
+		}
+		defer watcher.Stop()
+		for event := range watcher.ResultChan() {
+			if event.Type == watch.Error {
+				return
+			}
+			w.notify()
+		}
+	}, watchRetryPeriod)
+}
+
+// syncMemberWatches starts watches for member clusters that joined the graph and stops the ones that left it.
+func (w *topologyWatcher) syncMemberWatches(ctx context.Context, resp *TopologyResponse) {
+	clusters := map[string]bool{}
+	for _, node := range resp.Nodes {
+		if node.Type == NodeTypeWork && node.Cluster != "" {
+			clusters[node.Cluster] = true
+		}
+	}
+	for cluster, cancel := range w.members {
+		if !clusters[cluster] {
+			cancel()
+			delete(w.members, cluster)
+		}
+	}
+	for cluster := range clusters {
+		if _, ok := w.members[cluster]; ok {
+			continue
+		}
+		memberCtx, cancel := context.WithCancel(ctx)
+		w.members[cluster] = cancel
+		w.startMemberWatches(memberCtx, cluster)
+	}
+}
+
+// startMemberWatches watches the workload and, for pod-owning kinds, its pods in a member cluster.
+// Member clients are built inside the watch start functions so that failures are retried with the watch.
+func (w *topologyWatcher) startMemberWatches(ctx context.Context, clusterName string) {
+	go w.runWatch(ctx, func(ctx context.Context) (watch.Interface, error) {
+		memberDynamicClient, err := client.DynamicClientForMemberClusterFromRequest(w.request, clusterName)
+		if err != nil {
+			return nil, fmt.Errorf("get member cluster %s client: %w", clusterName, err)
+		}
+		return memberDynamicClient.Resource(w.res.GVR).Namespace(w.namespace).Watch(ctx, nameListOptions(w.name))
+	})
+
+	if !hasPods(w.res.Kind) {
+		return
+	}
+	go w.runWatch(ctx, func(ctx context.Context) (watch.Interface, error) {
+		memberClient, err := client.ClientForMemberClusterFromRequest(w.request, clusterName)
+		if err != nil {
+			return nil, fmt.Errorf("get member cluster %s client: %w", clusterName, err)
+		}
+		_, labels, err := getMemberWorkloadInfo(ctx, memberClient, w.namespace, w.name, w.res.Kind)
+		if err != nil {
+			return nil, err
+		}
+		return memberClient.CoreV1().Pods(w.namespace).Watch(ctx, metav1.ListOptions{LabelSelector: labels.String()})
+	})
+}
+
+// stopMemberWatches stops all member cluster watches.
+func (w *topologyWatcher) stopMemberWatches() {
+	for cluster, cancel := range w.members {
+		cancel()
+		delete(w.members, cluster)
+	}
+}
+
+// apply replaces the current graph with resp and returns the deltas between them.
+func (w *topologyWatcher) apply(resp *TopologyResponse) []TopologyDelta {
+	nodes := make(map[string]TopologyNode, len(resp.Nodes))
+	for _, node := range resp.Nodes {
+		nodes[node.ID] = node
+	}
+	edges := make(map[string]TopologyEdge, len(resp.Edges))
+	for _, edge := range resp.Edges {
+		edges[edgeKey(edge)] = edge
+	}
+	deltas := diffTopology(w.nodes, w.edges, nodes, edges)
+	w.nodes, w.edges = nodes, edges
+	return deltas
+}
+
+// diffTopology computes node and edge deltas between two graphs.
+// Node deltas are ordered before edge deltas so that clients never see dangling edges on addition.
+func diffTopology(oldNodes map[string]TopologyNode, oldEdges map[string]TopologyEdge,
+	newNodes map[string]TopologyNode, newEdges map[string]TopologyEdge) []TopologyDelta {
+	var deltas []TopologyDelta
+	for _, id := range sortedKeys(newNodes) {
+		node := newNodes[id]
+		old, exists := oldNodes[id]
+		switch {
+		case !exists:
+			deltas = append(deltas, TopologyDelta{Type: DeltaTypeAdd, Node: &node})
+		case !reflect.DeepEqual(old, node):
+			deltas = append(deltas, TopologyDelta{Type: DeltaTypeUpdate, Node: &node})
+		}
+	}
+	for _, key := range sortedKeys(newEdges) {
+		edge := newEdges[key]
+		old, exists := oldEdges[key]
+		switch {
+		case !exists:
+			deltas = append(deltas, TopologyDelta{Type: DeltaTypeAdd, Edge: &edge})
+		case !reflect.DeepEqual(old, edge):
+			deltas = append(deltas, TopologyDelta{Type: DeltaTypeUpdate, Edge: &edge})
+		}
+	}
+	for _, key := range sortedKeys(oldEdges) {
+		if _, exists := newEdges[key]; !exists {
+			edge := oldEdges[key]
+			deltas = append(deltas, TopologyDelta{Type: DeltaTypeRemove, Edge: &edge})
+		}
+	}
+	for _, id := range sortedKeys(oldNodes) {
+		if _, exists := newNodes[id]; !exists {
+			node := oldNodes[id]
+			deltas = append(deltas, TopologyDelta{Type: DeltaTypeRemove, Node: &node})
+		}
+	}
+	return deltas
+}
+
+func edgeKey(edge TopologyEdge) string {
+	return edge.Source + "->" + edge.Target
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func nameListOptions(name string) metav1.ListOptions {
+	return metav1.ListOptions{FieldSelector: fields.OneTermEqualSelector("metadata.name", name).String()}
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topology
+
+import (
+	"testing"
+
+	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestTopologyWatcherApply(t *testing.T) {
+	w := &topologyWatcher{
+		nodes: map[string]TopologyNode{},
+		edges: map[string]TopologyEdge{},
+	}
+
+	initial := &TopologyResponse{
+		Nodes: []TopologyNode{
+			{ID: "rt-1", Type: NodeTypeResourceTemplate, Status: NodeStatusHealthy},
+			{ID: "rb-1", Type: NodeTypeResourceBinding, Status: NodeStatusHealthy},
+		},
+		Edges: []TopologyEdge{{Source: "rt-1", Target: "rb-1"}},
+	}
+	deltas := w.apply(initial)
+	if len(deltas) != 3 {
+		t.Fatalf("expected 3 deltas for the initial graph, got %d", len(deltas))
+	}
+	for _, d := range deltas {
+		if d.Type != DeltaTypeAdd {
+			t.Errorf("expected only additions for the initial graph, got %s", d.Type)
+		}
+	}
+	if deltas[2].Edge == nil {
+		t.Errorf("expected edges to be emitted after nodes")
+	}
+
+	if deltas := w.apply(initial); len(deltas) != 0 {
+		t.Errorf("expected no deltas for an unchanged graph, got %d", len(deltas))
+	}
+
+	next := &TopologyResponse{
+		Nodes: []TopologyNode{
+			{ID: "rt-1", Type: NodeTypeResourceTemplate, Status: NodeStatusProgressing},
+			{ID: "rb-2", Type: NodeTypeResourceBinding, Status: NodeStatusHealthy},
+		},
+		Edges: []TopologyEdge{{Source: "rt-1", Target: "rb-2"}},
+	}
+	deltas = w.apply(next)
+	want := []struct {
+		typ    DeltaType
+		id     string
+		isEdge bool
+	}{
+		{DeltaTypeAdd, "rb-2", false},
+		{DeltaTypeUpdate, "rt-1", false},
+		{DeltaTypeAdd, "rt-1->rb-2", true},
+		{DeltaTypeRemove, "rt-1->rb-1", true},
+		{DeltaTypeRemove, "rb-1", false},
+	}
+	if len(deltas) != len(want) {
+		t.Fatalf("expected %d deltas, got %d: %+v", len(want), len(deltas), deltas)
+	}
+	for i, d := range deltas {
+		id := ""
+		if d.Node != nil {
+			id = d.Node.ID
+		}
+		if d.Edge != nil {
+			id = edgeKey(*d.Edge)
+		}
+		if d.Type != want[i].typ || id != want[i].id || (d.Edge != nil) != want[i].isEdge {
+			t.Errorf("delta %d = %s %s, want %s %s", i, d.Type, id, want[i].typ, want[i].id)
+		}
+	}
+}
+
+func TestTopologyWatcherIsOwnBinding(t *testing.T) {
+	w := &topologyWatcher{
+		res:       &resourceInfo{GVR: schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}, Kind: "Deployment"},
+		namespace: "default",
+		name:      "nginx",
+	}
+	binding := func(apiVersion, kind, namespace, name string) *workv1alpha2.ResourceBinding {
+		return &workv1alpha2.ResourceBinding{Spec: workv1alpha2.ResourceBindingSpec{Resource: workv1alpha2.ObjectReference{
+			APIVersion: apiVersion, Kind: kind, Namespace: namespace, Name: name, UID: "recreated",
+		}}}
+	}
+
+	tests := []struct {
+		name string
+		obj  interface{}
+		want bool
+	}{
+		{name: "same template, new UID", obj: binding("apps/v1", "Deployment", "default", "nginx"), want: true},
+		{name: "other name", obj: binding("apps/v1", "Deployment", "default", "redis"), want: false},
+		{name: "other namespace", obj: binding("apps/v1", "Deployment", "kube-system", "nginx"), want: false},
+		{name: "other group", obj: binding("example.io/v1", "Deployment", "default", "nginx"), want: false},
+		{name: "not a binding", obj: &workv1alpha1.Work{}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := w.isOwnBinding(tt.obj); got != tt.want {
+				t.Errorf("isOwnBinding() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
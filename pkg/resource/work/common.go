@@ -0,0 +1,101 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package work
+
+import (
+	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/util/names"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+	"github.com/karmada-io/dashboard/pkg/resource/resourcebinding"
+)
+
+// WorkCell is a wrapper around Work type
+type WorkCell workv1alpha1.Work
+
+// GetProperty returns the given property of the Work.
+func (c WorkCell) GetProperty(name dataselect.PropertyName) dataselect.ComparableValue {
+	switch name {
+	case dataselect.NameProperty:
+		return dataselect.StdComparableString(c.ObjectMeta.Name)
+	case dataselect.CreationTimestampProperty:
+		return dataselect.StdComparableTime(c.ObjectMeta.CreationTimestamp.Time)
+	case dataselect.NamespaceProperty:
+		return dataselect.StdComparableString(c.ObjectMeta.Namespace)
+	case dataselect.ClusterProperty:
+		return dataselect.StdComparableStringSlice{clusterName(c.ObjectMeta.Namespace)}
+	case dataselect.AppliedProperty:
+		return dataselect.StdComparableString(resourcebinding.ConditionStatus(c.Status.Conditions, workv1alpha1.WorkApplied))
+	case dataselect.ResourceKindProperty, dataselect.ResourceNameProperty:
+		values := make([]string, 0, len(c.Spec.Workload.Manifests))
+		for _, manifest := range manifestRefs(c.Spec.Workload.Manifests) {
+			if name == dataselect.ResourceKindProperty {
+				values = append(values, manifest.Kind)
+			} else {
+				values = append(values, manifest.Name)
+			}
+		}
+		return dataselect.StdComparableStringSlice(values)
+	default:
+		// if name is not supported then just return a constant dummy value, sort will have no effect.
+		return nil
+	}
+}
+
+// clusterName returns the member cluster of a Work from its execution namespace.
+func clusterName(namespace string) string {
+	cluster, err := names.GetClusterName(namespace)
+	if err != nil {
+		return ""
+	}
+	return cluster
+}
+
+// manifestRefs identifies the resources carried by a Work, skipping manifests that fail to decode.
+func manifestRefs(manifests []workv1alpha1.Manifest) []ManifestRef {
+	refs := make([]ManifestRef, 0, len(manifests))
+	for _, manifest := range manifests {
+		obj := &unstructured.Unstructured{}
+		if err := obj.UnmarshalJSON(manifest.Raw); err != nil {
+			continue
+		}
+		refs = append(refs, ManifestRef{
+			APIVersion: obj.GetAPIVersion(),
+			Kind:       obj.GetKind(),
+			Namespace:  obj.GetNamespace(),
+			Name:       obj.GetName(),
+		})
+	}
+	return refs
+}
+
+func toCells(std []workv1alpha1.Work) []dataselect.DataCell {
+	cells := make([]dataselect.DataCell, len(std))
+	for i := range std {
+		cells[i] = WorkCell(std[i])
+	}
+	return cells
+}
+
+func fromCells(cells []dataselect.DataCell) []workv1alpha1.Work {
+	std := make([]workv1alpha1.Work, len(cells))
+	for i := range std {
+		std[i] = workv1alpha1.Work(cells[i].(WorkCell))
+	}
+	return std
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package work
+
+import (
+	"context"
+
+	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+)
+
+// WorkDetail is a presentation layer view of Karmada Work resource. Besides the list item it carries the
+// manifests and the per-manifest status reported by the member cluster, including apply errors.
+type WorkDetail struct {
+	// Extends list item structure.
+	Work `json:",inline"`
+
+	Spec   workv1alpha1.WorkSpec   `json:"spec"`
+	Status workv1alpha1.WorkStatus `json:"status"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// GetWorkDetail gets Work details.
+func GetWorkDetail(client karmadaclientset.Interface, namespace, name string) (*WorkDetail, error) {
+	workData, err := client.WorkV1alpha1().Works(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	work := toWorkDetail(workData, nonCriticalErrors)
+	return &work, nil
+}
+
+func toWorkDetail(work *workv1alpha1.Work, nonCriticalErrors []error) WorkDetail {
+	return WorkDetail{
+		Work:   toWork(work),
+		Spec:   work.Spec,
+		Status: work.Status,
+		Errors: nonCriticalErrors,
+	}
+}
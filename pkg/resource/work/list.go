@@ -0,0 +1,121 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package work
+
+import (
+	"context"
+
+	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/common/helpers"
+	"github.com/karmada-io/dashboard/pkg/common/types"
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+	"github.com/karmada-io/dashboard/pkg/resource/common"
+	"github.com/karmada-io/dashboard/pkg/resource/resourcebinding"
+)
+
+// WorkList contains a list of Works in the karmada control-plane.
+type WorkList struct {
+	ListMeta types.ListMeta `json:"listMeta"`
+
+	// Unordered list of Works.
+	Works []Work `json:"works"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// Work contains information about a single Work.
+type Work struct {
+	ObjectMeta types.ObjectMeta `json:"objectMeta"`
+	TypeMeta   types.TypeMeta   `json:"typeMeta"`
+	// Cluster is the member cluster the Work is applied to, derived from its execution namespace.
+	Cluster   string        `json:"cluster"`
+	Manifests []ManifestRef `json:"manifests"`
+	// Binding is the ResourceBinding or ClusterResourceBinding the Work was created for, nil if unknown.
+	Binding *BindingRef            `json:"binding,omitempty"`
+	Applied metav1.ConditionStatus `json:"applied"`
+}
+
+// ManifestRef identifies a resource carried by a Work.
+type ManifestRef struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+}
+
+// BindingRef is a reference to the binding owning a Work.
+type BindingRef struct {
+	Name           string `json:"name"`
+	Namespace      string `json:"namespace,omitempty"`
+	IsClusterScope bool   `json:"isClusterScope"`
+}
+
+// GetWorkList returns a list of all Works in the karmada control-plane.
+// Besides the usual properties, dsQuery can filter on cluster, applied, resourceKind and resourceName.
+func GetWorkList(client karmadaclientset.Interface, nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) (*WorkList, error) {
+	works, err := client.WorkV1alpha1().Works(nsQuery.ToRequestParam()).List(context.TODO(), helpers.ListEverything)
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	return toWorkList(works.Items, nonCriticalErrors, dsQuery), nil
+}
+
+func toWorkList(works []workv1alpha1.Work, nonCriticalErrors []error, dsQuery *dataselect.DataSelectQuery) *WorkList {
+	workList := &WorkList{
+		Works:    make([]Work, 0),
+		ListMeta: types.ListMeta{TotalItems: len(works)},
+	}
+	workCells, filteredTotal := dataselect.GenericDataSelectWithFilter(toCells(works), dsQuery)
+	works = fromCells(workCells)
+	workList.ListMeta = types.ListMeta{TotalItems: filteredTotal}
+	workList.Errors = nonCriticalErrors
+
+	for i := range works {
+		workList.Works = append(workList.Works, toWork(&works[i]))
+	}
+	return workList
+}
+
+func toWork(work *workv1alpha1.Work) Work {
+	return Work{
+		ObjectMeta: types.NewObjectMeta(work.ObjectMeta),
+		TypeMeta:   types.NewTypeMeta(types.ResourceKindWork),
+		Cluster:    clusterName(work.Namespace),
+		Manifests:  manifestRefs(work.Spec.Workload.Manifests),
+		Binding:    bindingRef(work.Annotations),
+		Applied:    resourcebinding.ConditionStatus(work.Status.Conditions, workv1alpha1.WorkApplied),
+	}
+}
+
+// bindingRef reads the owning binding from the annotations the binding controllers put on a Work.
+func bindingRef(annotations map[string]string) *BindingRef {
+	if name := annotations[workv1alpha2.ResourceBindingNameAnnotationKey]; name != "" {
+		return &BindingRef{Name: name, Namespace: annotations[workv1alpha2.ResourceBindingNamespaceAnnotationKey]}
+	}
+	if name := annotations[workv1alpha2.ClusterResourceBindingAnnotationKey]; name != "" {
+		return &BindingRef{Name: name, IsClusterScope: true}
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package work
+
+import (
+	"reflect"
+	"testing"
+
+	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/karmada-io/dashboard/pkg/common/types"
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+)
+
+func TestToWorkList(t *testing.T) {
+	work := workv1alpha1.Work{
+		ObjectMeta: metaV1.ObjectMeta{
+			Name:      "nginx-687f7fb96f",
+			Namespace: "karmada-es-member1",
+			Annotations: map[string]string{
+				workv1alpha2.ResourceBindingNameAnnotationKey:      "nginx-deployment",
+				workv1alpha2.ResourceBindingNamespaceAnnotationKey: "default",
+			},
+		},
+		Spec: workv1alpha1.WorkSpec{
+			Workload: workv1alpha1.WorkloadTemplate{
+				Manifests: []workv1alpha1.Manifest{
+					{RawExtension: runtime.RawExtension{Raw: []byte(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"nginx","namespace":"default"}}`)}},
+				},
+			},
+		},
+		Status: workv1alpha1.WorkStatus{
+			Conditions: []metaV1.Condition{{Type: workv1alpha1.WorkApplied, Status: metaV1.ConditionFalse}},
+		},
+	}
+	expected := Work{
+		ObjectMeta: types.ObjectMeta{
+			Name:        "nginx-687f7fb96f",
+			Namespace:   "karmada-es-member1",
+			Annotations: work.Annotations,
+		},
+		TypeMeta:  types.TypeMeta{Kind: types.ResourceKindWork},
+		Cluster:   "member1",
+		Manifests: []ManifestRef{{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "default", Name: "nginx"}},
+		Binding:   &BindingRef{Name: "nginx-deployment", Namespace: "default"},
+		Applied:   metaV1.ConditionFalse,
+	}
+
+	cases := []struct {
+		filterBy []string
+		expected []Work
+	}{
+		{[]string{}, []Work{expected}},
+		{[]string{dataselect.ClusterProperty, "member1"}, []Work{expected}},
+		{[]string{dataselect.ClusterProperty, "member"}, []Work{}},
+		{[]string{dataselect.AppliedProperty, "False", dataselect.ResourceNameProperty, "nginx"}, []Work{expected}},
+		{[]string{dataselect.ResourceKindProperty, "StatefulSet"}, []Work{}},
+	}
+	for _, c := range cases {
+		dsQuery := dataselect.NewDataSelectQuery(dataselect.NoPagination, dataselect.NoSort, dataselect.NewFilterQuery(c.filterBy))
+		actual := toWorkList([]workv1alpha1.Work{work}, nil, dsQuery)
+		if !reflect.DeepEqual(actual.Works, c.expected) {
+			t.Errorf("toWorkList() with filter %v == \n%#v\nexpected \n%#v\n", c.filterBy, actual.Works, c.expected)
+		}
+	}
+}
@@ -22,7 +22,12 @@ import {
   useState,
   useCallback,
 } from 'react';
-import { Me, MeResponse } from '@/services/auth.ts';
+import {
+  Logout,
+  Me,
+  MeResponse,
+  PermissionMatrix,
+} from '@/services/auth.ts';
 import { karmadaClient } from '@/services';
 import { useQuery } from '@tanstack/react-query';
 import { karmadaMemberClusterClient } from "@/services/base.ts";
@@ -33,19 +38,51 @@ export interface AuthUser {
   email?: string;
   preferredUsername?: string;
   authType?: string;
+  username?: string;
+  groups?: string[];
+}
+
+// can reports whether the user may perform verb on resource, e.g. can('delete', 'deployments.apps', 'default').
+// Cluster-scoped resources are checked without a namespace. Resources and namespaces /me did not review
+// are allowed, the apiserver still has the final word.
+function can(
+  permissions: PermissionMatrix | undefined,
+  verb: string,
+  resource: string,
+  namespace?: string,
+) {
+  if (!permissions) {
+    return true;
+  }
+  const scoped = namespace
+    ? permissions.namespaces[namespace]
+    : permissions.cluster;
+  if (!scoped) {
+    return true;
+  }
+  // Only namespaced rules can be incomplete, cluster-scoped verbs are reviewed
+  // one by one.
+  return (
+    !!scoped[resource]?.includes(verb) ||
+    (!!namespace && permissions.incomplete)
+  );
 }
 
 const AuthContext = createContext<{
   authenticated: boolean;
   token: string;
   user: AuthUser | null;
+  can: (verb: string, resource: string, namespace?: string) => boolean;
   setToken: (v: string) => void;
+  setSession: () => void;
   logout: () => void;
 }>({
   authenticated: false,
   token: '',
   user: null,
+  can: () => true,
   setToken: () => { },
+  setSession: () => { },
   logout: () => { },
 });
 
@@ -55,16 +92,46 @@ const AuthProvider = ({ children }: { children: ReactNode }) => {
     localStorage.setItem('token', newToken);
     setToken_(newToken);
   }, []);
+  // OIDC logins are carried by an HttpOnly session cookie, the browser never sees the token.
+  const [session, setSession_] = useState(
+    localStorage.getItem('session') === 'oidc',
+  );
+  const setSession = useCallback(() => {
+    localStorage.setItem('session', 'oidc');
+    setSession_(true);
+  }, []);
   const logout = useCallback(() => {
     localStorage.removeItem('token');
+    localStorage.removeItem('session');
     sessionStorage.removeItem('oidc_state');
     delete karmadaClient.defaults.headers.common['Authorization'];
     delete karmadaMemberClusterClient.defaults.headers.common['Authorization'];
     setToken_('');
-  }, []);
+    if (session) {
+      setSession_(false);
+      Logout()
+        .then((ret) => {
+          if (ret.data?.endSessionUrl) {
+            window.location.href = ret.data.endSessionUrl;
+          }
+        })
+        .catch(() => { });
+    }
+  }, [session]);
   const { data, isLoading } = useQuery({
-    queryKey: ['Me', token],
+    queryKey: ['Me', token, session],
     queryFn: async () => {
+      if (!token && session) {
+        try {
+          const ret = await Me();
+          return ret.data as MeResponse;
+        } catch (e) {
+          localStorage.removeItem('session');
+          return {
+            authenticated: false,
+          } as MeResponse;
+        }
+      }
       if (token) {
         karmadaClient.defaults.headers.common[
           'Authorization'
@@ -85,7 +152,7 @@ const AuthProvider = ({ children }: { children: ReactNode }) => {
     },
   });
   const ctxValue = useMemo(() => {
-    if (data && token) {
+    if (data && (token || session)) {
       return {
         authenticated: !!data.authenticated,
         token,
@@ -94,8 +161,13 @@ const AuthProvider = ({ children }: { children: ReactNode }) => {
           email: data.email,
           preferredUsername: data.preferredUsername,
           authType: data.authType,
+          username: data.username,
+          groups: data.groups,
         },
+        can: (verb: string, resource: string, namespace?: string) =>
+          can(data.permissions, verb, resource, namespace),
         setToken,
+        setSession,
         logout,
       };
     } else {
@@ -103,11 +175,13 @@ const AuthProvider = ({ children }: { children: ReactNode }) => {
         authenticated: false,
         token: '',
         user: null,
+        can: () => false,
         setToken,
+        setSession,
         logout,
       };
     }
-  }, [data, token, setToken, logout]);
+  }, [data, token, session, setToken, setSession, logout]);
   return (
     <AuthContext.Provider value={ctxValue}>
       {!isLoading && children}
@@ -21,8 +21,10 @@ import { GetClusters } from '@/services';
 import {
   Cluster,
   ClusterDetail,
+  ClusterOperation,
   DeleteCluster,
   GetClusterDetail,
+  WaitForClusterOperation,
 } from '@/services/cluster';
 import {
   Badge,
@@ -39,6 +41,10 @@ import {
 import { Icons } from '@/components/icons';
 import NewClusterModal from './new-cluster-modal';
 import { useState } from 'react';
+import { useAuth } from '@/components/auth';
+
+const clusterResource = 'clusters.cluster.karmada.io';
+
 function getPercentColor(v: number): string {
   // 0~60 #52C41A
   // 60~80 #FAAD14
@@ -53,6 +59,7 @@ function getPercentColor(v: number): string {
 }
 const ClusterManagePage = () => {
   const [messageApi, messageContextHolder] = message.useMessage();
+  const { can } = useAuth();
   const { data, isLoading, refetch } = useQuery({
     queryKey: ['ClusterManagePage', 'GetClusters'],
     queryFn: async () => {
@@ -60,6 +67,27 @@ const ClusterManagePage = () => {
       return ret.data;
     },
   });
+  // Joins and deletes run as background cluster operations, their response only means that one started.
+  const waitForOperation = async (
+    operation: ClusterOperation,
+    runningText: string,
+    successText: string,
+    failureText: string,
+  ) => {
+    const key = operation.id;
+    void messageApi.loading({ key, content: runningText, duration: 0 });
+    const ret = await WaitForClusterOperation(operation.id);
+    await refetch();
+    if (ret.code === 200 && ret.data.phase === 'Succeeded') {
+      await messageApi.success({ key, content: successText });
+      return;
+    }
+    const reason = ret.code === 200 ? ret.data.error : ret.message;
+    await messageApi.error({
+      key,
+      content: reason ? `${failureText}: ${reason}` : failureText,
+    });
+  };
   const [clusterModalData, setModalData] = useState<{
     mode: 'create' | 'edit';
     open: boolean;
@@ -202,6 +230,7 @@ const ClusterManagePage = () => {
             <Button
               size={'small'}
               type="link"
+              disabled={!can('update', clusterResource)}
               onClick={async () => {
                 const ret = await GetClusterDetail(r.objectMeta.name);
                 setModalData({
@@ -215,18 +244,26 @@ const ClusterManagePage = () => {
             </Button>
             <Popconfirm
               placement="topRight"
+              disabled={!can('delete', clusterResource)}
               title={i18nInstance.t('30ee910e8ea18311b1b2efbea94333b8', {
                 name: r.objectMeta.name,
               })}
               onConfirm={async () => {
                 const ret = await DeleteCluster(r.objectMeta.name);
                 if (ret.code === 200) {
-                  await messageApi.success(
+                  await waitForOperation(
+                    ret.data,
+                    i18nInstance.t('a2ac45503d095a06eda2e636b496797a', {
+                      name: r.objectMeta.name,
+                    }),
                     i18nInstance.t('fb09e53e96ff76a72894a816dd7c731c', {
                       name: r.objectMeta.name,
                     }),
+                    i18nInstance.t(
+                      '9e7856e9c5938b9200dbdc174e97cf8a',
+                      '集群删除失败',
+                    ),
                   );
-                  await refetch();
                 } else {
                   await messageApi.error(
                     i18nInstance.t(
@@ -245,7 +282,12 @@ const ClusterManagePage = () => {
                 '取消',
               )}
             >
-              <Button size={'small'} type="link" danger>
+              <Button
+                size={'small'}
+                type="link"
+                danger
+                disabled={!can('delete', clusterResource)}
+              >
                 {i18nInstance.t('2f4aaddde33c9b93c36fd2503f3d122b', '删除')}
               </Button>
             </Popconfirm>
@@ -268,6 +310,7 @@ const ClusterManagePage = () => {
           type={'primary'}
           icon={<Icons.add width={16} height={16} />}
           className="flex flex-row items-center"
+          disabled={!can('create', clusterResource)}
           onClick={() => {
             setModalData({
               mode: 'create',
@@ -290,12 +333,26 @@ const ClusterManagePage = () => {
         open={clusterModalData.open}
         onOk={async (ret) => {
           if (ret.code === 200) {
+            setModalData({
+              clusterDetail: undefined,
+              mode: 'create',
+              open: false,
+            });
             if (clusterModalData.mode === 'create') {
-              await messageApi.success(
+              const operation = ret.data as ClusterOperation;
+              await waitForOperation(
+                operation,
+                i18nInstance.t('3d6eb99e5f6035076b9f64a20c8ce796', {
+                  name: operation.clusterName,
+                }),
                 i18nInstance.t(
                   'dca2754f7a646ef40f495f75145428d0',
                   '集群接入成功',
                 ),
+                i18nInstance.t(
+                  '3b0b5df2e18ef97b7f948c60906a7821',
+                  '集群接入失败',
+                ),
               );
             } else if (clusterModalData.mode === 'edit') {
               await messageApi.success(
@@ -304,13 +361,8 @@ const ClusterManagePage = () => {
                   '集群更新成功',
                 ),
               );
+              await refetch();
             }
-            await refetch();
-            setModalData({
-              clusterDetail: undefined,
-              mode: 'create',
-              open: false,
-            });
           } else {
             if (clusterModalData.mode === 'create') {
               await messageApi.error(
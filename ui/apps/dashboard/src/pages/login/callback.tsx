@@ -27,7 +27,7 @@ const processedOIDCCallbacks = new Set<string>();
 const OIDCCallbackPage = () => {
   const [searchParams] = useSearchParams();
   const navigate = useNavigate();
-  const { setToken } = useAuth();
+  const { setSession } = useAuth();
   const [error, setError] = useState<string | null>(null);
 
   useEffect(() => {
@@ -67,8 +67,8 @@ const OIDCCallbackPage = () => {
         const ret = await OIDCCallback(code, state);
         sessionStorage.removeItem('oidc_state');
 
-        if (ret.code === 200 && ret.data?.token) {
-          setToken(ret.data.token);
+        if (ret.code === 200 && ret.data?.authenticated) {
+          setSession();
           navigate('/overview');
         } else {
           setError(
@@ -82,7 +82,7 @@ const OIDCCallbackPage = () => {
     };
 
     handleCallback();
-  }, [searchParams, navigate, setToken]);
+  }, [searchParams, navigate, setSession]);
 
   if (error) {
     return (
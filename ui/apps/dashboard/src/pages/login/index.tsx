@@ -19,7 +19,12 @@ import { Button, Card, Input, Segmented, Typography, message } from 'antd';
 import styles from './index.module.less';
 import { cn } from '@/utils/cn.ts';
 import { useCallback, useEffect, useMemo, useState } from 'react';
-import { GetOIDCEnabled, GetOIDCLoginURL, Login } from '@/services/auth.ts';
+import {
+  GetOIDCEnabled,
+  GetOIDCLoginURL,
+  Login,
+  OIDCProvider,
+} from '@/services/auth.ts';
 import { useNavigate } from 'react-router-dom';
 import { useAuth } from '@/components/auth';
 import { Check, ChevronDown, ChevronUp, Copy } from 'lucide-react';
@@ -57,9 +62,11 @@ const LoginPage = () => {
   const [authToken, setAuthToken] = useState('');
   const [tokenError, setTokenError] = useState('');
   const [showTokenCommand, setShowTokenCommand] = useState(false);
-  const [oidcEnabled, setOIDCEnabled] = useState(false);
+  const [oidcProviders, setOIDCProviders] = useState<OIDCProvider[]>([]);
+  const oidcEnabled = oidcProviders.length > 0;
   const [isSubmitting, setIsSubmitting] = useState(false);
-  const [isOIDCLoading, setIsOIDCLoading] = useState(false);
+  const [oidcLoadingProvider, setOIDCLoadingProvider] = useState('');
+  const isOIDCLoading = !!oidcLoadingProvider;
   const [copiedCommand, setCopiedCommand] = useState(false);
   const [messageApi, contextHolder] = message.useMessage();
   const navigate = useNavigate();
@@ -74,18 +81,20 @@ const LoginPage = () => {
     const loadOIDCEnabled = async () => {
       try {
         const ret = await GetOIDCEnabled();
-        setOIDCEnabled(ret.code === 200 && !!ret.data?.enabled);
+        setOIDCProviders(
+          ret.code === 200 && ret.data?.enabled ? ret.data.providers || [] : [],
+        );
       } catch (_) {
-        setOIDCEnabled(false);
+        setOIDCProviders([]);
       }
     };
     void loadOIDCEnabled();
   }, []);
 
-  const handleOIDCLogin = async () => {
-    setIsOIDCLoading(true);
+  const handleOIDCLogin = async (provider: string) => {
+    setOIDCLoadingProvider(provider);
     try {
-      const ret = await GetOIDCLoginURL();
+      const ret = await GetOIDCLoginURL(provider);
       if (ret.code === 200 && ret.data) {
         sessionStorage.setItem('oidc_state', ret.data.state);
         window.location.href = ret.data.authUrl;
@@ -99,7 +108,7 @@ const LoginPage = () => {
         i18nInstance.t('oidc_login_error', '企业登录未完成，请重试'),
       );
     } finally {
-      setIsOIDCLoading(false);
+      setOIDCLoadingProvider('');
     }
   };
 
@@ -296,17 +305,20 @@ const LoginPage = () => {
               <Typography.Text className={styles['section-desc']}>
                 {i18nInstance.t('enterprise_login_desc', '使用企业身份统一认证登录')}
               </Typography.Text>
-              <div className={styles['action-row']}>
-                <Button
-                  type="primary"
-                  data-testid="oidc-login-button"
-                  loading={isOIDCLoading}
-                  disabled={isSubmitting || !oidcEnabled}
-                  onClick={handleOIDCLogin}
-                >
-                  {i18nInstance.t('enterprise_login', '企业登录')}
-                </Button>
-              </div>
+              {oidcProviders.map((provider) => (
+                <div className={styles['action-row']} key={provider.id}>
+                  <Button
+                    type="primary"
+                    data-testid={`oidc-login-button-${provider.id}`}
+                    loading={oidcLoadingProvider === provider.id}
+                    disabled={isSubmitting || isOIDCLoading}
+                    onClick={() => handleOIDCLogin(provider.id)}
+                  >
+                    {provider.displayName ||
+                      i18nInstance.t('enterprise_login', '企业登录')}
+                  </Button>
+                </div>
+              ))}
             </section>
           )}
         </Card>
@@ -48,6 +48,7 @@ import OverridePolicyEditorDrawer, {
   OverridePolicyEditorDrawerProps,
 } from './override-policy-editor-drawer.tsx';
 import { GetNamespaces } from '@/services/namespace.ts';
+import { useAuth } from '@/components/auth';
 
 export type PolicyScope = 'namespace-scope' | 'cluster-scope';
 const OverridePolicyManage = () => {
@@ -60,6 +61,14 @@ const OverridePolicyManage = () => {
     selectedWorkSpace: '',
     searchText: '',
   });
+  const { can } = useAuth();
+  // Policies created without a chosen namespace land in the one of their YAML,
+  // which is not known up front.
+  const canPolicy = (verb: string, namespace?: string) =>
+    filter.policyScope === 'cluster-scope'
+      ? can(verb, 'clusteroverridepolicies.policy.karmada.io')
+      : !namespace ||
+        can(verb, 'overridepolicies.policy.karmada.io', namespace);
   const { data, isLoading, refetch } = useQuery({
     queryKey: ['GetOverridePolicies', JSON.stringify(filter)],
     queryFn: async () => {
@@ -189,6 +198,7 @@ const OverridePolicyManage = () => {
             <Button
               size={'small'}
               type="link"
+              disabled={!canPolicy('update', r.objectMeta.namespace)}
               onClick={async () => {
                 const ret = await GetResource({
                   name: r.objectMeta.name,
@@ -212,6 +222,7 @@ const OverridePolicyManage = () => {
             </Button>
             <Popconfirm
               placement="topRight"
+              disabled={!canPolicy('delete', r.objectMeta.namespace)}
               title={i18nInstance.t('1af8d577b89a4caf0e4b30734bbf7143', {
                 name: r.objectMeta.name,
               })}
@@ -241,7 +252,12 @@ const OverridePolicyManage = () => {
               okText={'确认'}
               cancelText={'取消'}
             >
-              <Button size={'small'} type="link" danger>
+              <Button
+                size={'small'}
+                type="link"
+                danger
+                disabled={!canPolicy('delete', r.objectMeta.namespace)}
+              >
                 {i18nInstance.t('2f4aaddde33c9b93c36fd2503f3d122b', '删除')}
               </Button>
             </Popconfirm>
@@ -340,6 +356,7 @@ const OverridePolicyManage = () => {
             type={'primary'}
             icon={<Icons.add width={16} height={16} />}
             className="flex flex-row items-center"
+            disabled={!canPolicy('create', filter.selectedWorkSpace)}
             onClick={() => {
               setEditorDrawerData({
                 open: true,
@@ -46,6 +46,7 @@ import { GetResource } from '@/services/unstructured.ts';
 import { useDebounce } from '@uidotdev/usehooks';
 import { PolicyScope } from '@/services/base.ts';
 import useNamespace from '@/hooks/use-namespace.ts';
+import { useAuth } from '@/components/auth';
 
 const PropagationPolicyManage = () => {
   const [filter, setFilter] = useState<{
@@ -58,6 +59,14 @@ const PropagationPolicyManage = () => {
     searchText: '',
   });
   const debouncedSearchText = useDebounce(filter.searchText, 300);
+  const { can } = useAuth();
+  // Policies created without a chosen namespace land in the one of their YAML,
+  // which is not known up front.
+  const canPolicy = (verb: string, namespace?: string) =>
+    filter.policyScope === PolicyScope.Cluster
+      ? can(verb, 'clusterpropagationpolicies.policy.karmada.io')
+      : !namespace ||
+        can(verb, 'propagationpolicies.policy.karmada.io', namespace);
   const { data, isLoading, refetch } = useQuery({
     queryKey: [
       'GetPropagationPolicies',
@@ -175,6 +184,7 @@ const PropagationPolicyManage = () => {
             <Button
               size={'small'}
               type="link"
+              disabled={!canPolicy('update', r.objectMeta.namespace)}
               onClick={async () => {
                 const ret = await GetResource({
                   name: r.objectMeta.name,
@@ -198,6 +208,7 @@ const PropagationPolicyManage = () => {
             </Button>
             <Popconfirm
               placement="topRight"
+              disabled={!canPolicy('delete', r.objectMeta.namespace)}
               title={i18nInstance.t('b13c676134d8ab066d62e9ea5bdf796c', {
                 name: r.objectMeta.name,
               })}
@@ -233,7 +244,12 @@ const PropagationPolicyManage = () => {
                 '取消',
               )}
             >
-              <Button size={'small'} type="link" danger>
+              <Button
+                size={'small'}
+                type="link"
+                danger
+                disabled={!canPolicy('delete', r.objectMeta.namespace)}
+              >
                 {i18nInstance.t('2f4aaddde33c9b93c36fd2503f3d122b', '删除')}
               </Button>
             </Popconfirm>
@@ -333,6 +349,7 @@ const PropagationPolicyManage = () => {
             type={'primary'}
             icon={<Icons.add width={16} height={16} />}
             className="flex flex-row items-center"
+            disabled={!canPolicy('create', filter.selectedNamespace)}
             onClick={() => {
               setEditorDrawerData({
                 open: true,
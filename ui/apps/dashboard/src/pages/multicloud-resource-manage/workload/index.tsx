@@ -41,8 +41,19 @@ import TagList, { convertLabelToTags } from '@/components/tag-list';
 import { WorkloadKind } from '@/services/base.ts';
 import useNamespace from '@/hooks/use-namespace.ts';
 import WorkloadFilter from '@/components/workload-filter';
+import { useAuth } from '@/components/auth';
 
 const propagationpolicyKey = 'propagationpolicy.karmada.io/name';
+// workloadResources maps a workload kind to the resource its permissions are
+// reported under.
+const workloadResources: Record<WorkloadKind, string> = {
+  [WorkloadKind.Unknown]: '',
+  [WorkloadKind.Deployment]: 'deployments.apps',
+  [WorkloadKind.Statefulset]: 'statefulsets.apps',
+  [WorkloadKind.Daemonset]: 'daemonsets.apps',
+  [WorkloadKind.Cronjob]: 'cronjobs.batch',
+  [WorkloadKind.Job]: 'jobs.batch',
+};
 const WorkloadPage = () => {
   const [filter, setFilter] = useState<{
     kind: WorkloadKind;
@@ -55,6 +66,9 @@ const WorkloadPage = () => {
   });
 
   const { nsOptions, isNsDataLoading } = useNamespace({});
+  const { can } = useAuth();
+  const canWorkload = (verb: string, namespace?: string) =>
+    !namespace || can(verb, workloadResources[filter.kind], namespace);
   const { data, isLoading, refetch } = useQuery({
     queryKey: ['GetWorkloads', JSON.stringify(filter)],
     queryFn: async () => {
@@ -160,6 +174,7 @@ const WorkloadPage = () => {
             <Button
               size={'small'}
               type="link"
+              disabled={!canWorkload('update', r.objectMeta.namespace)}
               onClick={async () => {
                 const ret = await GetResource({
                   kind: r.typeMeta.kind,
@@ -178,6 +193,7 @@ const WorkloadPage = () => {
 
             <Popconfirm
               placement="topRight"
+              disabled={!canWorkload('delete', r.objectMeta.namespace)}
               title={i18nInstance.t('f0ade52acfa0bc5bd63e7cb29db84959', {
                 name: r.objectMeta.name,
               })}
@@ -201,7 +217,12 @@ const WorkloadPage = () => {
                 '取消',
               )}
             >
-              <Button size={'small'} type="link" danger>
+              <Button
+                size={'small'}
+                type="link"
+                danger
+                disabled={!canWorkload('delete', r.objectMeta.namespace)}
+              >
                 {i18nInstance.t('2f4aaddde33c9b93c36fd2503f3d122b', '删除')}
               </Button>
             </Popconfirm>
@@ -250,6 +271,7 @@ const WorkloadPage = () => {
             type={'primary'}
             icon={<Icons.add width={16} height={16} />}
             className="flex flex-row items-center"
+            disabled={!canWorkload('create', filter.selectedWorkSpace)}
             onClick={() => {
               toggleShowModal(true);
             }}
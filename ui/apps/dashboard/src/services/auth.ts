@@ -16,12 +16,27 @@ limitations under the License.
 
 import { IResponse, karmadaClient } from '@/services/base.ts';
 
+// Permissions maps a resource key, e.g. deployments.apps or secrets, to the verbs allowed on it.
+export type Permissions = Record<string, string[]>;
+
+export interface PermissionMatrix {
+  cluster: Permissions;
+  namespaces: Record<string, Permissions>;
+  // Set when the apiserver could not list every rule, missing verbs may still be allowed.
+  incomplete: boolean;
+}
+
 export interface MeResponse {
   authenticated: boolean;
   name?: string;
   email?: string;
   preferredUsername?: string;
   authType?: string;
+  username?: string;
+  uid?: string;
+  groups?: string[];
+  extra?: Record<string, string[]>;
+  permissions?: PermissionMatrix;
 }
 
 export async function Login(token: string) {
@@ -43,30 +58,45 @@ export async function Me() {
   return resp.data;
 }
 
+export interface OIDCProvider {
+  id: string;
+  displayName: string;
+}
+
 export async function GetOIDCEnabled() {
   const resp = await karmadaClient.get<
     IResponse<{
       enabled: boolean;
+      providers: OIDCProvider[];
     }>
   >(`/auth/oidc/enabled`);
   return resp.data;
 }
 
-export async function GetOIDCLoginURL() {
+export async function GetOIDCLoginURL(provider: string) {
   const resp = await karmadaClient.get<
     IResponse<{
       authUrl: string;
       state: string;
     }>
-  >(`/auth/oidc/login`);
+  >(`/auth/oidc/login`, { params: { provider } });
   return resp.data;
 }
 
 export async function OIDCCallback(code: string, state: string) {
   const resp = await karmadaClient.get<
     IResponse<{
-      token: string;
+      authenticated: boolean;
     }>
   >(`/auth/oidc/callback`, { params: { code, state } });
   return resp.data;
 }
+
+export async function Logout() {
+  const resp = await karmadaClient.post<
+    IResponse<{
+      endSessionUrl?: string;
+    }>
+  >(`/auth/logout`);
+  return resp.data;
+}
@@ -82,11 +82,14 @@ export async function CreateCluster(params: {
   mode: 'Push' | 'Pull';
 }) {
   // /api/v1/cluster
-  const resp = await karmadaClient.post<IResponse<string>>(`/cluster`, {
-    memberClusterKubeconfig: params.kubeconfig,
-    memberClusterName: params.clusterName,
-    syncMode: params.mode,
-  });
+  const resp = await karmadaClient.post<IResponse<ClusterOperation>>(
+    `/cluster`,
+    {
+      memberClusterKubeconfig: params.kubeconfig,
+      memberClusterName: params.clusterName,
+      syncMode: params.mode,
+    },
+  );
   return resp.data;
 }
 
@@ -115,8 +118,55 @@ export async function UpdateCluster(params: {
 }
 
 export async function DeleteCluster(clusterName: string) {
-  const resp = await karmadaClient.delete<IResponse<ClusterDetail>>(
+  const resp = await karmadaClient.delete<IResponse<ClusterOperation>>(
     `/cluster/${clusterName}`,
   );
   return resp.data;
 }
+
+export type ClusterOperationPhase =
+  | 'Pending'
+  | 'Running'
+  | 'Succeeded'
+  | 'Failed'
+  | 'RolledBack';
+
+export interface ClusterOperationStep {
+  name: string;
+  phase: ClusterOperationPhase;
+  message?: string;
+  startTime?: string;
+  completionTime?: string;
+}
+
+// ClusterOperation is a cluster join or unjoin running in the background.
+export interface ClusterOperation {
+  id: string;
+  user: string;
+  type: 'Join' | 'Unjoin';
+  clusterName: string;
+  syncMode?: 'Pull' | 'Push';
+  phase: ClusterOperationPhase;
+  steps: ClusterOperationStep[];
+  error?: string;
+  startTime: string;
+  completionTime?: string;
+}
+
+export async function GetClusterOperation(id: string) {
+  const resp = await karmadaClient.get<IResponse<ClusterOperation>>(
+    `/clusteroperation/${id}`,
+  );
+  return resp.data;
+}
+
+// WaitForClusterOperation polls the operation until it is no longer running.
+export async function WaitForClusterOperation(id: string, interval = 2000) {
+  for (;;) {
+    const ret = await GetClusterOperation(id);
+    if (ret.code !== 200 || ret.data.phase !== 'Running') {
+      return ret;
+    }
+    await new Promise((resolve) => setTimeout(resolve, interval));
+  }
+}